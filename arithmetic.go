@@ -0,0 +1,578 @@
+package interval
+
+import "math"
+
+/*
+	Private Type for an endpoint of an operand in interval arithmetic.
+
+	side is +1 for a LowerBound and -1 for an UpperBound: an Open 0 LowerBound is approached from above (0+)
+	and an Open 0 UpperBound from below (0-), which decides the sign of a division by it. outward is true if
+	float results computed from the operand are rounded outwards.
+*/
+type operand[N Numeric] struct {
+	Value    N
+	infinity int // -1 for -∞, +1 for +∞ and 0 for a finite Value
+	open     bool
+	side     int
+	outward  bool
+}
+
+/* Private function that returns the LowerBound and UpperBound of an interval as operands */
+func operands[N Numeric](interval Interval[N], outward bool) (lower, upper operand[N]) {
+	lower = operand[N]{Value: interval.LowerBound.Value, open: interval.LowerBound.Type == OpenPoint, side: 1}
+	if interval.LowerBound.IsInfinite() {
+		lower = operand[N]{infinity: -1, open: true, side: 1}
+	}
+	upper = operand[N]{Value: interval.UpperBound.Value, open: interval.UpperBound.Type == OpenPoint, side: -1}
+	if interval.UpperBound.IsInfinite() {
+		upper = operand[N]{infinity: 1, open: true, side: -1}
+	}
+	lower.outward, upper.outward = outward, outward
+	return lower, upper
+}
+
+/* Private Boolean Method that returns true if the operand is a finite 0 */
+func (self operand[N]) isZero() bool {
+	return self.infinity == 0 && self.Value == 0
+}
+
+/* Private Method that returns the sign of an operand, using the side an Open 0 is approached from */
+func (self operand[N]) sign() int {
+	switch {
+	case self.infinity != 0:
+		return self.infinity
+	case self.Value > 0:
+		return 1
+	case self.Value < 0:
+		return -1
+	}
+	return self.side
+}
+
+/*
+	Private Type for a candidate endpoint of the result of an operation, as the LowerBound and as the
+	UpperBound it would give. The two only differ for infinities, Values that overflow N and integer quotients
+	rounded inwards.
+*/
+type candidate[N Numeric] struct {
+	lower Point[N]
+	upper Point[N]
+}
+
+/* Private function that returns a candidate for a Value, Open if it is only approached */
+func exactCandidate[N Numeric](Value N, open bool) candidate[N] {
+	point := Point[N]{Value: Value, Type: ClosedPoint}
+	if open {
+		point.Type = OpenPoint
+	}
+	return candidate[N]{lower: point, upper: point}
+}
+
+/*
+	Private function that returns the candidate for a float Value rounded from an exact result, where rounding
+	is the sign of the rounding error (exact - Value) or unknownRounding. A LowerBound above the exact result
+	steps down to the previous float and an UpperBound below it steps up to the next one, so the candidate
+	still encloses the exact result.
+*/
+func roundedCandidate[N Numeric](Value N, open bool, rounding int) candidate[N] {
+	next := exactCandidate(Value, open)
+	switch {
+	case rounding == unknownRounding:
+		next.lower.Value, next.upper.Value = nextValue(Value, false), nextValue(Value, true)
+	case rounding < 0:
+		next.lower.Value = nextValue(Value, false)
+	case rounding > 0:
+		next.upper.Value = nextValue(Value, true)
+	}
+	/* stepping beyond the largest float leaves the range of N */
+	if isInf(next.lower.Value) {
+		next.lower = NegativeInfinity[N]()
+	}
+	if isInf(next.upper.Value) {
+		next.upper = PositiveInfinity[N]()
+	}
+	return next
+}
+
+/* Private function that returns the -∞ (sign -1) or +∞ (sign +1) candidate */
+func infiniteCandidate[N Numeric](sign int) candidate[N] {
+	if sign < 0 {
+		return candidate[N]{lower: NegativeInfinity[N](), upper: NegativeInfinity[N]()}
+	}
+	return candidate[N]{lower: PositiveInfinity[N](), upper: PositiveInfinity[N]()}
+}
+
+/*
+	Private function that returns the candidate for a finite result that lies beyond the range of N, below it
+	(sign -1) or above it (sign +1). As an UpperBound it gives the infinity on its side; as a LowerBound above
+	the range it gives the Open largest Value of N, ex: the int8 sum [100,120] + [100,120] is (127,+∞).
+*/
+func overflowCandidate[N Numeric](sign int) candidate[N] {
+	if sign < 0 {
+		return candidate[N]{lower: NegativeInfinity[N](), upper: Point[N]{Value: lowestValue[N](), Type: OpenPoint}}
+	}
+	return candidate[N]{lower: Point[N]{Value: highestValue[N](), Type: OpenPoint}, upper: PositiveInfinity[N]()}
+}
+
+/* Private function that returns the candidate of the sum of two operands on the same side */
+func sumCandidate[N Numeric](x, y operand[N]) candidate[N] {
+	switch {
+	case x.infinity != 0:
+		return infiniteCandidate[N](x.infinity)
+	case y.infinity != 0:
+		return infiniteCandidate[N](y.infinity)
+	}
+	sum, overflow := addValues(x.Value, y.Value)
+	if overflow != 0 {
+		return overflowCandidate[N](overflow)
+	}
+	if x.outward {
+		return roundedCandidate(sum, x.open || y.open, sumRounding(x.Value, y.Value, sum))
+	}
+	return exactCandidate(sum, x.open || y.open)
+}
+
+/* Private function that returns the candidate of the difference of two operands on opposite sides */
+func differenceCandidate[N Numeric](x, y operand[N]) candidate[N] {
+	switch {
+	case x.infinity != 0:
+		return infiniteCandidate[N](x.infinity)
+	case y.infinity != 0:
+		return infiniteCandidate[N](-y.infinity)
+	}
+	difference, overflow := subValues(x.Value, y.Value)
+	if overflow != 0 {
+		return overflowCandidate[N](overflow)
+	}
+	if x.outward {
+		return roundedCandidate(difference, x.open || y.open, sumRounding(x.Value, -y.Value, difference))
+	}
+	return exactCandidate(difference, x.open || y.open)
+}
+
+/*
+	Private function that returns the candidate of the product of two operands. A Closed 0 is a member of its
+	interval, so its products are an attained 0 whatever the other operand. The product of an Open 0 and an
+	infinity is indeterminate and gives no candidate.
+*/
+func productCandidate[N Numeric](x, y operand[N]) (candidate[N], bool) {
+	switch {
+	case x.isZero() && !x.open, y.isZero() && !y.open:
+		return exactCandidate(N(0), false), true
+	case x.infinity != 0 || y.infinity != 0:
+		if x.isZero() || y.isZero() {
+			return candidate[N]{}, false
+		}
+		return infiniteCandidate[N](x.sign() * y.sign()), true
+	}
+	product, overflow := mulValues(x.Value, y.Value)
+	if overflow != 0 {
+		return overflowCandidate[N](overflow), true
+	}
+	if x.outward {
+		return roundedCandidate(product, x.open || y.open, productRounding(x.Value, y.Value, product)), true
+	}
+	return exactCandidate(product, x.open || y.open), true
+}
+
+/*
+	Private function that returns the candidate of the quotient of two operands, where y is never a Closed 0.
+	∞/∞ and 0/0 are indeterminate and give no candidate. Integer quotients are rounded inwards: the integers
+	above 1/2 start at 1 and the integers below 1/2 end at 0.
+*/
+func quotientCandidate[N Numeric](x, y operand[N]) (candidate[N], bool) {
+	switch {
+	case x.isZero() && !x.open:
+		return exactCandidate(N(0), false), true
+	case x.infinity != 0 && y.infinity != 0, x.isZero() && y.isZero():
+		return candidate[N]{}, false
+	case y.infinity != 0:
+		return exactCandidate(N(0), true), true
+	case x.infinity != 0, y.isZero():
+		return infiniteCandidate[N](x.sign() * y.sign()), true
+	}
+	lower, upper, exact, overflow := quoValues(x.Value, y.Value)
+	if overflow != 0 {
+		return overflowCandidate[N](overflow), true
+	}
+	if exact && x.outward {
+		return roundedCandidate(lower, x.open || y.open, quotientRounding(x.Value, y.Value, lower)), true
+	}
+	if exact {
+		return exactCandidate(lower, x.open || y.open), true
+	}
+	return candidate[N]{lower: Point[N]{Value: lower, Type: ClosedPoint}, upper: Point[N]{Value: upper, Type: ClosedPoint}}, true
+}
+
+/* Private function that builds the interval enclosing the candidates of a result */
+func candidateInterval[N Numeric](candidates []candidate[N]) Interval[N] {
+	if len(candidates) == 0 {
+		return GenerateEmptyInterval[N]()
+	}
+	start, end := candidates[0].lower, candidates[0].upper
+	for _, next := range candidates[1:] {
+		start, end = startPointHull(start, next.lower), endPointHull(end, next.upper)
+	}
+	/* an integer quotient may hold no integer: [1,1] / [2,2] */
+	if boundsEmpty(start, end) {
+		return GenerateEmptyInterval[N]()
+	}
+	return GenerateInterval(start, end)
+}
+
+/* Private function that combines every pair of endpoints of two intervals into candidates */
+func cornerInterval[N Numeric](a, b Interval[N], outward bool, corner func(x, y operand[N]) (candidate[N], bool)) Interval[N] {
+	aLower, aUpper := operands(a, outward)
+	bLower, bUpper := operands(b, outward)
+	candidates := make([]candidate[N], 0, 4)
+	for _, x := range []operand[N]{aLower, aUpper} {
+		for _, y := range []operand[N]{bLower, bUpper} {
+			if next, ok := corner(x, y); ok {
+				candidates = append(candidates, next)
+			}
+		}
+	}
+	return candidateInterval(candidates)
+}
+
+/* SECTION: Interval Arithmetic Functions */
+
+/*
+	Public Interval Function that returns the sum of two intervals: every x + y for x in a and y in b,
+	[a,b] + [c,d] = [a+c,b+d]. An endpoint is Open if either endpoint it is computed from is Open.
+
+	Integer endpoints that overflow N are widened rather than wrapped, so the result always encloses the
+	exact sum: an UpperBound beyond the range of N becomes +∞ and a LowerBound beyond it becomes Open at
+	the largest Value of N. Float endpoints are rounded to nearest, see OutwardAdd for a rounding that keeps the
+	enclosure.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c Interval[N]	a + b, Empty if either is Empty
+*/
+func Add[N Numeric](a, b Interval[N]) Interval[N] {
+	return addIntervals(a, b, false)
+}
+
+/*
+	Public Interval Function that returns the difference of two intervals: every x - y for x in a and y in b,
+	[a,b] - [c,d] = [a-d,b-c]. See Add for Open endpoints and overflows.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c Interval[N]	a - b, Empty if either is Empty
+*/
+func Sub[N Numeric](a, b Interval[N]) Interval[N] {
+	return subIntervals(a, b, false)
+}
+
+/*
+	Public Interval Function that returns the negation of an interval, -[a,b] = [-b,-a]. See Add for overflows,
+	ex: the negation of a uint interval [1,2] is (-∞,0).
+
+	Parameters:
+		a Interval[N]
+	Return:
+		c Interval[N]	-a, Empty if a is Empty
+*/
+func Neg[N Numeric](a Interval[N]) Interval[N] {
+	return Sub(GenerateClosedInterval[N](0, 0), a)
+}
+
+/*
+	Public Interval Function that returns the product of two intervals: every x * y for x in a and y in b,
+	the hull of the products of their endpoints, [a,b] * [c,d] = [min(ac,ad,bc,bd), max(ac,ad,bc,bd)].
+
+	A Closed 0 endpoint is a member of its interval, so [0,1] * (2,3) = [0,3) and [0,0] * (-∞,+∞) = [0,0].
+	See Add for overflows.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c Interval[N]	a * b, Empty if either is Empty
+*/
+func Mul[N Numeric](a, b Interval[N]) Interval[N] {
+	return mulIntervals(a, b, false)
+}
+
+/*
+	Public IntervalSet Function that returns the quotient of two intervals: every x / y for x in a and y in b
+	with y ≠ 0.
+
+	A divisor that holds 0 is split into its negative and positive parts, so the quotient may be two
+	intervals: [1,2] / [-1,1] = (-∞,-1] ∪ [1,+∞). Dividing by [0,0] gives the empty set.
+
+	Integer quotients hold the integers of the exact quotient rather than truncated Values:
+	[1,5] / [2,2] = [1,2] since the exact quotient is [0.5,2.5]. See Add for overflows.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c IntervalSet[N]	a / b
+*/
+func Div[N Numeric](a, b Interval[N]) IntervalSet[N] {
+	return divIntervals(a, b, false)
+}
+
+/* !SECTION: Interval Arithmetic Functions */
+
+/* SECTION: Private Interval Arithmetic Functions */
+
+/* Private function that returns a + b, with float endpoints rounded outwards if outward is true */
+func addIntervals[N Numeric](a, b Interval[N], outward bool) Interval[N] {
+	if a.Type == EmptyInterval || b.Type == EmptyInterval {
+		return GenerateEmptyInterval[N]()
+	}
+	aLower, aUpper := operands(a, outward)
+	bLower, bUpper := operands(b, outward)
+	return candidateInterval([]candidate[N]{
+		{lower: sumCandidate(aLower, bLower).lower, upper: sumCandidate(aUpper, bUpper).upper},
+	})
+}
+
+/* Private function that returns a - b, with float endpoints rounded outwards if outward is true */
+func subIntervals[N Numeric](a, b Interval[N], outward bool) Interval[N] {
+	if a.Type == EmptyInterval || b.Type == EmptyInterval {
+		return GenerateEmptyInterval[N]()
+	}
+	aLower, aUpper := operands(a, outward)
+	bLower, bUpper := operands(b, outward)
+	return candidateInterval([]candidate[N]{
+		{lower: differenceCandidate(aLower, bUpper).lower, upper: differenceCandidate(aUpper, bLower).upper},
+	})
+}
+
+/* Private function that returns a * b, with float endpoints rounded outwards if outward is true */
+func mulIntervals[N Numeric](a, b Interval[N], outward bool) Interval[N] {
+	if a.Type == EmptyInterval || b.Type == EmptyInterval {
+		return GenerateEmptyInterval[N]()
+	}
+	return cornerInterval(a, b, outward, productCandidate[N])
+}
+
+/* Private function that returns a / b, with float endpoints rounded outwards if outward is true */
+func divIntervals[N Numeric](a, b Interval[N], outward bool) IntervalSet[N] {
+	quotient := NewIntervalSet[N]()
+	if a.Type == EmptyInterval || b.Type == EmptyInterval {
+		return quotient
+	}
+	if !b.Contains(0) {
+		quotient.Add(cornerInterval(a, b, outward, quotientCandidate[N]))
+		return quotient
+	}
+	for _, part := range []Interval[N]{Intersect(b, GenerateLessThanInterval[N](0)), Intersect(b, GenerateGreaterThanInterval[N](0))} {
+		if part.Type != EmptyInterval {
+			quotient.Add(cornerInterval(a, part, outward, quotientCandidate[N]))
+		}
+	}
+	return quotient
+}
+
+/* !SECTION: Private Interval Arithmetic Functions */
+
+/* SECTION: Overflow-Aware Value Arithmetic */
+
+/* Private function that returns the largest Value of N: the largest finite float or the largest integer */
+func highestValue[N Numeric]() N {
+	if isFloat32[N]() {
+		highest := math.MaxFloat32
+		return N(highest)
+	}
+	if isFloat[N]() {
+		highest := math.MaxFloat64
+		return N(highest)
+	}
+	if isUnsigned[N]() {
+		var zero N
+		return zero - 1
+	}
+	/* the largest signed integer is 2^k - 1, the last Value before 2^(k+1) - 1 wraps around */
+	var highest N = 1
+	for next := highest*2 + 1; next > highest; next = highest*2 + 1 {
+		highest = next
+	}
+	return highest
+}
+
+/* Private function that returns the lowest Value of N: the lowest finite float or the lowest integer */
+func lowestValue[N Numeric]() N {
+	if isUnsigned[N]() {
+		return 0
+	}
+	if isFloat[N]() {
+		return -highestValue[N]()
+	}
+	return -highestValue[N]() - 1
+}
+
+/* Private function that returns the sign of a Value that overflowed to a float infinity, 0 if it did not */
+func floatOverflow[N Numeric](Value N) int {
+	switch {
+	case !isInf(Value):
+		return 0
+	case Value > 0:
+		return 1
+	}
+	return -1
+}
+
+/*
+	Private function that returns a + b and, if the exact sum is beyond the range of N, the side it
+	overflowed to (-1 or +1).
+*/
+func addValues[N Numeric](a, b N) (N, int) {
+	sum := a + b
+	switch {
+	case isFloat[N]():
+		return sum, floatOverflow(sum)
+	case b > 0 && sum < a:
+		return sum, 1
+	case b < 0 && sum > a:
+		return sum, -1
+	}
+	return sum, 0
+}
+
+/* Private function that returns a - b and the side it overflowed to. See addValues. */
+func subValues[N Numeric](a, b N) (N, int) {
+	difference := a - b
+	switch {
+	case isFloat[N]():
+		return difference, floatOverflow(difference)
+	case b > 0 && difference > a:
+		return difference, -1
+	case b < 0 && difference < a:
+		return difference, 1
+	}
+	return difference, 0
+}
+
+/* Private function that returns a * b and the side it overflowed to. See addValues. */
+func mulValues[N Numeric](a, b N) (N, int) {
+	product := a * b
+	if isFloat[N]() {
+		return product, floatOverflow(product)
+	}
+	if a == 0 || b == 0 {
+		return product, 0
+	}
+	sign := 1
+	if (a < 0) != (b < 0) {
+		sign = -1
+	}
+	/* the sign check catches -1 * min, whose quotient check wraps around to min */
+	if product/a != b || (product < 0) != (sign < 0) {
+		return product, sign
+	}
+	return product, 0
+}
+
+/*
+	Private function that returns a / b, with b ≠ 0, rounded inwards for integers: lower is the smallest
+	integer at or above the exact quotient and upper the largest integer at or below it. exact is true if the
+	quotient is a Value of N, in which case lower and upper are equal. Float quotients are always exact.
+*/
+func quoValues[N Numeric](a, b N) (lower, upper N, exact bool, overflow int) {
+	if isFloat[N]() {
+		quotient := a / b
+		return quotient, quotient, true, floatOverflow(quotient)
+	}
+	/* min / -1 is the only integer quotient beyond the range of N */
+	if b < 0 && b+1 == 0 && a < 0 && -a == a {
+		return a, a, true, 1
+	}
+	quotient := a / b
+	remainder := a - quotient*b
+	if remainder == 0 {
+		return quotient, quotient, true, 0
+	}
+	/* a / b truncates towards 0: it is the floor of a positive quotient and the ceiling of a negative one */
+	if (remainder > 0) == (b > 0) {
+		return quotient + 1, quotient, false, 0
+	}
+	return quotient, quotient - 1, false, 0
+}
+
+/* !SECTION: Overflow-Aware Value Arithmetic */
+
+/* SECTION: Float Rounding Errors */
+
+/* the rounding of a result whose direction is unknown, when computing its error overflowed */
+const unknownRounding = 2
+
+/* Private function that returns the sign of a Value: -1, 0 or +1 */
+func signOf[N Numeric](Value N) int {
+	switch {
+	case Value > 0:
+		return 1
+	case Value < 0:
+		return -1
+	}
+	return 0
+}
+
+/*
+	Private function that returns the sign of the rounding error a + b - sum of a finite float sum, 0 for
+	integers. The error is computed exactly from the sum (TwoSum), each step rounded to N.
+*/
+func sumRounding[N Numeric](a, b, sum N) int {
+	if !isFloat[N]() {
+		return 0
+	}
+	virtualB := N(sum - a)
+	virtualA := N(sum - virtualB)
+	residual := N(a-virtualA) + N(b-virtualB)
+	if isNaN(residual) || isInf(residual) {
+		return unknownRounding
+	}
+	return signOf(residual)
+}
+
+/*
+	Private function that returns the sign of the rounding error a * b - product of a finite float product, 0 for
+	integers. A float32 product is exact as a float64. A float64 product is scaled by the exponents of a and b
+	so that its error, computed with a fused multiply-add, cannot underflow.
+*/
+func productRounding[N Numeric](a, b, product N) int {
+	switch {
+	case !isFloat[N]():
+		return 0
+	case isFloat32[N]():
+		return signOf(float64(a)*float64(b) - float64(product))
+	case product == 0:
+		/* the exact product is a * b itself, nonzero if it underflowed */
+		return signOf(a) * signOf(b)
+	}
+	aFraction, aExponent := math.Frexp(float64(a))
+	bFraction, bExponent := math.Frexp(float64(b))
+	scaled := math.Ldexp(float64(product), -aExponent-bExponent)
+	return signOf(math.FMA(aFraction, bFraction, -scaled))
+}
+
+/*
+	Private function that returns the sign of the rounding error a / b - quotient of a finite float quotient, 0 for
+	integers. It is the sign of the remainder a - quotient * b, flipped for a negative b, scaled as in
+	productRounding for float64.
+*/
+func quotientRounding[N Numeric](a, b, quotient N) int {
+	switch {
+	case !isFloat[N]():
+		return 0
+	case isFloat32[N]():
+		return signOf(float64(a)-float64(quotient)*float64(b)) * signOf(b)
+	case quotient == 0 || a == 0:
+		return signOf(a) * signOf(b)
+	}
+	aFraction, aExponent := math.Frexp(float64(a))
+	bFraction, bExponent := math.Frexp(float64(b))
+	scaled := math.Ldexp(float64(quotient), bExponent-aExponent)
+	return signOf(math.FMA(-scaled, bFraction, aFraction)) * signOf(b)
+}
+
+/* !SECTION: Float Rounding Errors */
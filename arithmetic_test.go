@@ -0,0 +1,210 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+/* SECTION: Arithmetic Testing */
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		a        Interval[float64]
+		b        Interval[float64]
+		expected string
+	}{
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(3.0, 5.0), "[4,7]"},
+		{GenerateClosedOpenInterval(1.0, 2.0), GenerateOpenClosedInterval(3.0, 5.0), "(4,7)"},
+		{GenerateClosedInterval(1.0, 2.0), GenerateAtLeastInterval(3.0), "[4,+∞)"},
+		{GenerateLessThanInterval(1.0), GenerateClosedInterval(3.0, 5.0), "(-∞,6)"},
+		{GenerateLessThanInterval(1.0), GenerateGreaterThanInterval(3.0), "(-∞,+∞)"},
+		{GenerateClosedInterval(2.0, 2.0), GenerateClosedInterval(-2.0, -2.0), "[0,0]"},
+		{GenerateEmptyInterval[float64](), GenerateClosedInterval(3.0, 5.0), "{}"},
+		{GenerateClosedInterval(math.MaxFloat64, math.MaxFloat64), GenerateClosedInterval(0.0, math.MaxFloat64), "[1.7976931348623157e+308,+∞)"},
+	}
+	for _, test := range tests {
+		sum := Add(test.a, test.b)
+		AssertEqual(sum.String(), test.expected, t)
+		sum = Add(test.b, test.a)
+		AssertEqual(sum.String(), test.expected, t)
+	}
+}
+
+func TestSubAndNeg(t *testing.T) {
+	tests := []struct {
+		a        Interval[int]
+		b        Interval[int]
+		expected string
+	}{
+		{GenerateClosedInterval(1, 2), GenerateClosedInterval(3, 5), "[-4,-1]"},
+		{GenerateClosedOpenInterval(1, 2), GenerateClosedInterval(3, 5), "[-4,-1)"},
+		{GenerateClosedInterval(1, 2), GenerateOpenClosedInterval(3, 5), "[-4,-1)"},
+		{GenerateAtLeastInterval(1), GenerateAtMostInterval(3), "[-2,+∞)"},
+		{GenerateAtLeastInterval(1), GenerateAtLeastInterval(3), "(-∞,+∞)"},
+	}
+	for _, test := range tests {
+		difference := Sub(test.a, test.b)
+		AssertEqual(difference.String(), test.expected, t)
+		AssertTrue(Equal(difference, Add(test.a, Neg(test.b))), t)
+	}
+	negation := Neg(GenerateOpenClosedInterval(-1, 4))
+	AssertEqual(negation.String(), "[-4,1)", t)
+	negation = Neg(GenerateGreaterThanInterval(2))
+	AssertEqual(negation.String(), "(-∞,-2)", t)
+	negation = Neg(GenerateEmptyInterval[int]())
+	AssertEqual(negation.Type, EmptyInterval, t)
+}
+
+func TestIntegerOverflow(t *testing.T) {
+	tests := []struct {
+		result   Interval[int8]
+		expected string
+	}{
+		{Add(GenerateClosedInterval[int8](100, 120), GenerateClosedInterval[int8](100, 120)), "(127,+∞)"},
+		{Add(GenerateClosedInterval[int8](0, 120), GenerateClosedInterval[int8](0, 120)), "[0,+∞)"},
+		{Sub(GenerateClosedInterval[int8](-100, 0), GenerateClosedInterval[int8](0, 100)), "(-∞,0]"},
+		{Neg(GenerateClosedInterval[int8](-128, 0)), "[0,+∞)"},
+		{Mul(GenerateClosedInterval[int8](-100, 2), GenerateClosedInterval[int8](3, 50)), "(-∞,100]"},
+		{Mul(GenerateClosedInterval[int8](-128, -128), GenerateClosedInterval[int8](-1, -1)), "(127,+∞)"},
+		{Mul(GenerateClosedInterval[int8](-128, -128), GenerateClosedInterval[int8](-1, 1)), "[-128,+∞)"},
+	}
+	for _, test := range tests {
+		AssertEqual(test.result.String(), test.expected, t)
+	}
+	negation := Neg(GenerateClosedInterval[uint](1, 2))
+	AssertEqual(negation.String(), "(-∞,0)", t)
+	difference := Sub(GenerateClosedInterval[uint](5, 9), GenerateClosedInterval[uint](1, 7))
+	AssertEqual(difference.String(), "(-∞,8]", t)
+
+	quotient := Div(GenerateClosedInterval[int8](-128, -128), GenerateClosedInterval[int8](-1, -1))
+	AssertEqual(quotient.String(), "(127,+∞)", t)
+}
+
+func TestMul(t *testing.T) {
+	tests := []struct {
+		a        Interval[float64]
+		b        Interval[float64]
+		expected string
+	}{
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(3.0, 5.0), "[3,10]"},
+		{GenerateClosedInterval(-1.0, 2.0), GenerateClosedInterval(3.0, 5.0), "[-5,10]"},
+		{GenerateClosedInterval(-2.0, -1.0), GenerateClosedInterval(-5.0, 3.0), "[-6,10]"},
+		{GenerateOpenInterval(-1.0, 1.0), GenerateClosedInterval(-1.0, 1.0), "(-1,1)"},
+		{GenerateClosedInterval(-1.0, 1.0), GenerateOpenInterval(1.0, 2.0), "(-2,2)"},
+		{GenerateClosedInterval(0.0, 1.0), GenerateOpenInterval(2.0, 3.0), "[0,3)"},
+		{GenerateOpenClosedInterval(0.0, 1.0), GenerateOpenInterval(2.0, 3.0), "(0,3)"},
+		{GenerateClosedInterval(0.0, 0.0), GenerateUnboundedInterval[float64](), "[0,0]"},
+		{GenerateClosedInterval(0.0, 1.0), GenerateAtLeastInterval(5.0), "[0,+∞)"},
+		{GenerateOpenClosedInterval(0.0, 1.0), GenerateAtLeastInterval(5.0), "(0,+∞)"},
+		{GenerateOpenInterval(-1.0, 0.0), GenerateAtLeastInterval(5.0), "(-∞,0)"},
+		{GenerateOpenClosedInterval(0.0, 1.0), GenerateUnboundedInterval[float64](), "(-∞,+∞)"},
+		{GenerateAtMostInterval(-1.0), GenerateAtMostInterval(-2.0), "[2,+∞)"},
+		{GenerateGreaterThanInterval(0.0), GenerateGreaterThanInterval(0.0), "(0,+∞)"},
+		{GenerateEmptyInterval[float64](), GenerateClosedInterval(3.0, 5.0), "{}"},
+	}
+	for _, test := range tests {
+		product := Mul(test.a, test.b)
+		AssertEqual(product.String(), test.expected, t)
+		product = Mul(test.b, test.a)
+		AssertEqual(product.String(), test.expected, t)
+	}
+}
+
+func TestDiv(t *testing.T) {
+	tests := []struct {
+		a        Interval[float64]
+		b        Interval[float64]
+		expected string
+	}{
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(4.0, 8.0), "[0.125,0.5]"},
+		{GenerateClosedInterval(-1.0, 2.0), GenerateOpenClosedInterval(-4.0, -2.0), "[-1,0.5]"},
+		{GenerateClosedInterval(1.0, 2.0), GenerateAtLeastInterval(1.0), "(0,2]"},
+		{GenerateClosedInterval(0.0, 2.0), GenerateAtLeastInterval(1.0), "[0,2]"},
+		{GenerateClosedInterval(1.0, 2.0), GenerateOpenClosedInterval(0.0, 1.0), "[1,+∞)"},
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(-1.0, 1.0), "(-∞,-1] ∪ [1,+∞)"},
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(0.0, 1.0), "[1,+∞)"},
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(-1.0, 0.0), "(-∞,-1]"},
+		{GenerateClosedInterval(-1.0, 2.0), GenerateClosedInterval(-1.0, 1.0), "(-∞,+∞)"},
+		{GenerateClosedInterval(0.0, 1.0), GenerateClosedInterval(0.0, 1.0), "[0,+∞)"},
+		{GenerateClosedInterval(0.0, 0.0), GenerateClosedInterval(-1.0, 1.0), "[0,0]"},
+		{GenerateOpenInterval(0.0, 1.0), GenerateOpenInterval(0.0, 1.0), "(0,+∞)"},
+		{GenerateAtLeastInterval(1.0), GenerateAtLeastInterval(1.0), "(0,+∞)"},
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(0.0, 0.0), "{}"},
+		{GenerateEmptyInterval[float64](), GenerateClosedInterval(1.0, 2.0), "{}"},
+	}
+	for _, test := range tests {
+		quotient := Div(test.a, test.b)
+		AssertEqual(quotient.String(), test.expected, t)
+	}
+}
+
+func TestIntegerDiv(t *testing.T) {
+	tests := []struct {
+		a        Interval[int]
+		b        Interval[int]
+		expected string
+	}{
+		{GenerateClosedInterval(1, 5), GenerateClosedInterval(2, 2), "[1,2]"},
+		{GenerateClosedInterval(-5, -1), GenerateClosedInterval(2, 2), "[-2,-1]"},
+		{GenerateClosedInterval(-5, 5), GenerateClosedInterval(2, 3), "[-2,2]"},
+		{GenerateClosedInterval(6, 9), GenerateOpenInterval(2, 3), "(2,4]"},
+		{GenerateClosedInterval(1, 1), GenerateClosedInterval(2, 2), "{}"},
+		{GenerateClosedInterval(2, 4), GenerateClosedInterval(-2, 2), "(-∞,-1] ∪ [1,+∞)"},
+	}
+	for _, test := range tests {
+		quotient := Div(test.a, test.b)
+		AssertEqual(quotient.String(), test.expected, t)
+	}
+}
+
+/*
+	Private helper that returns sample Values of a float interval, including Values just inside its endpoints.
+	The samples are dyadic so that their sums, differences and products are exact.
+*/
+func arithmeticSamples(interval Interval[float64]) []float64 {
+	var samples []float64
+	for Value := -4.0; Value <= 4.0; Value += 0.5 {
+		samples = append(samples, Value, Value+1.0/64, Value-1.0/64)
+	}
+	samples = append(samples, 1e6, -1e6)
+	var members []float64
+	for _, Value := range samples {
+		if interval.Contains(Value) {
+			members = append(members, Value)
+		}
+	}
+	return members
+}
+
+func TestArithmeticEncloses(t *testing.T) {
+	var intervals []Interval[float64]
+	for lower := -2.0; lower <= 2.0; lower++ {
+		for upper := lower; upper <= 2.0; upper++ {
+			intervals = append(intervals,
+				GenerateClosedInterval(lower, upper), GenerateOpenInterval(lower, upper),
+				GenerateOpenClosedInterval(lower, upper), GenerateClosedOpenInterval(lower, upper))
+		}
+		intervals = append(intervals, GenerateAtLeastInterval(lower), GenerateLessThanInterval(lower))
+	}
+	intervals = append(intervals, GenerateUnboundedInterval[float64]())
+
+	failures := 0
+	for _, a := range intervals {
+		for _, b := range intervals {
+			sum, difference, product, quotient := Add(a, b), Sub(a, b), Mul(a, b), Div(a, b)
+			for _, x := range arithmeticSamples(a) {
+				for _, y := range arithmeticSamples(b) {
+					if !sum.Contains(x+y) || !difference.Contains(x-y) || !product.Contains(x*y) {
+						failures++
+					}
+					if y != 0 && !quotient.Contains(x/y) {
+						failures++
+					}
+				}
+			}
+		}
+	}
+	AssertEqual(failures, 0, t)
+}
+
+/* !SECTION: Arithmetic Testing */
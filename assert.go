@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"testing"
 
-	. "golang.org/x/exp/slices"
+	"golang.org/x/exp/slices"
 )
 
 /*
@@ -47,7 +47,7 @@ func AssertEqual[T comparable](value, expected T, t *testing.T) {
 
 func AssertEqualSlice[T comparable](value, expected []T, t *testing.T) {
 	t.Run(fmt.Sprintf("AssertEqualSlice - %v == %v", value, expected), func(t *testing.T) {
-		if !Equal(value, expected) {
+		if !slices.Equal(value, expected) {
 			t.Error(preFormattedErrorString(value, expected))
 		}
 	})
@@ -55,7 +55,7 @@ func AssertEqualSlice[T comparable](value, expected []T, t *testing.T) {
 
 func AssertNotEqualSlice[T comparable](value, expected []T, t *testing.T) {
 	t.Run(fmt.Sprintf("AssertNotEqualSlice - %v != %v", value, expected), func(t *testing.T) {
-		if Equal(value, expected) {
+		if slices.Equal(value, expected) {
 			t.Error(preFormattedErrorString(value, expected))
 		}
 	})
@@ -0,0 +1,70 @@
+package interval
+
+import "math"
+
+/*
+	Public Method that returns the member of the interval nearest to a Value: the Value itself if the interval
+	contains it, and otherwise the endpoint on its side. An Open endpoint is not a member, so the nearest
+	representable member next to it is used instead: the next float (see math.Nextafter) or the next integer,
+	ex: 5 clamped into (0,3) is 2 over the integers and 2.9999999999999996 over float64.
+
+	Parameters:
+		Value N
+	Return:
+		N	the nearest member, or Value unchanged if there is none
+		bool	false if Value is NaN or if the interval holds no representable Value, ex: the Empty Interval
+			or (1,2) over the integers
+*/
+func (self Interval[N]) Clamp(Value N) (N, bool) {
+	if self.Type == EmptyInterval || isNaN(Value) {
+		return Value, false
+	}
+	if self.Contains(Value) {
+		return Value, true
+	}
+	var nearest N
+	if CompareBounds(Point[N]{Value: Value, Type: ClosedPoint}.AsUpper(), self.LowerBound.AsLower()) < 0 {
+		nearest = self.LowerBound.Value
+		if self.LowerBound.Type == OpenPoint {
+			nearest = nextValue(nearest, true)
+		}
+	} else {
+		nearest = self.UpperBound.Value
+		if self.UpperBound.Type == OpenPoint {
+			nearest = nextValue(nearest, false)
+		}
+	}
+	if !self.Contains(nearest) {
+		return Value, false
+	}
+	return nearest, true
+}
+
+/*
+	Public Method that returns how far a Value lies outside of the interval: 0 if the interval contains it or
+	if it is one of its Open endpoints, and otherwise its distance to the nearest endpoint, ex: 8.2 lies 3.2
+	above [0,5]. Use Contains to tell a member from a Value on an Open endpoint, and Clamp to find which side
+	a Value lies on.
+
+	The Empty Interval is infinitely far from every Value and a NaN Value returns NaN.
+
+	Parameters:
+		Value N
+	Return:
+		float64
+*/
+func (self Interval[N]) Distance(Value N) float64 {
+	if isNaN(Value) {
+		return math.NaN()
+	}
+	if self.Type == EmptyInterval {
+		return math.Inf(1)
+	}
+	if !self.LowerBound.IsInfinite() && Value < self.LowerBound.Value {
+		return float64(self.LowerBound.Value) - float64(Value)
+	}
+	if !self.UpperBound.IsInfinite() && Value > self.UpperBound.Value {
+		return float64(Value) - float64(self.UpperBound.Value)
+	}
+	return 0
+}
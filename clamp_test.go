@@ -0,0 +1,97 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+/* SECTION: Clamp Testing */
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		interval Interval[int]
+		Value    int
+		expected int
+	}{
+		{GenerateClosedInterval(0, 3), 2, 2},
+		{GenerateClosedInterval(0, 3), 5, 3},
+		{GenerateClosedInterval(0, 3), -5, 0},
+		{GenerateOpenInterval(0, 3), 5, 2},
+		{GenerateOpenInterval(0, 3), 0, 1},
+		{GenerateOpenInterval(0, 3), 3, 2},
+		{GenerateGreaterThanInterval(10), 4, 11},
+		{GenerateGreaterThanInterval(10), 40, 40},
+		{GenerateLessThanInterval(10), 40, 9},
+		{GenerateUnboundedInterval[int](), -40, -40},
+		{GenerateClosedInterval(7, 7), 40, 7},
+	}
+	for _, test := range tests {
+		clamped, ok := test.interval.Clamp(test.Value)
+		AssertEqual(clamped, test.expected, t)
+		AssertTrue(ok, t)
+	}
+}
+
+func TestFloatClamp(t *testing.T) {
+	tests := []struct {
+		interval Interval[float64]
+		Value    float64
+		expected float64
+	}{
+		{GenerateClosedInterval(0.0, 3.0), 4.5, 3},
+		{GenerateOpenInterval(0.0, 3.0), 4.5, math.Nextafter(3, 0)},
+		{GenerateOpenInterval(0.0, 3.0), -1, math.Nextafter(0, 1)},
+		{GenerateAtLeastInterval(1.5), -1, 1.5},
+	}
+	for _, test := range tests {
+		clamped, ok := test.interval.Clamp(test.Value)
+		AssertEqual(clamped, test.expected, t)
+		AssertTrue(ok && test.interval.Contains(clamped), t)
+	}
+	clamped, ok := GenerateOpenInterval[float32](0, 3).Clamp(4.5)
+	AssertEqual(clamped, math.Nextafter32(3, 0), t)
+	AssertTrue(ok, t)
+}
+
+func TestClampWithoutMember(t *testing.T) {
+	for _, interval := range []Interval[int]{
+		GenerateEmptyInterval[int](),
+		GenerateOpenInterval(1, 2),
+		GenerateGreaterThanInterval(math.MaxInt),
+	} {
+		clamped, ok := interval.Clamp(0)
+		AssertEqual(clamped, 0, t)
+		AssertFalse(ok, t)
+	}
+	_, ok := GenerateOpenInterval(1.0, math.Nextafter(1, 2)).Clamp(0)
+	AssertFalse(ok, t)
+
+	clamped, ok := GenerateOpenInterval(0.0, 3.0).Clamp(math.NaN())
+	AssertTrue(math.IsNaN(clamped), t)
+	AssertFalse(ok, t)
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		interval Interval[float64]
+		Value    float64
+		expected float64
+	}{
+		{GenerateClosedInterval(0.0, 5.0), 2, 0},
+		{GenerateClosedInterval(0.0, 5.0), 8.25, 3.25},
+		{GenerateClosedInterval(0.0, 5.0), -1.5, 1.5},
+		{GenerateOpenInterval(0.0, 5.0), 5, 0},
+		{GenerateAtMostInterval(5.0), -1e300, 0},
+		{GenerateAtMostInterval(5.0), 7, 2},
+		{GenerateGreaterThanInterval(5.0), 1, 4},
+		{GenerateUnboundedInterval[float64](), 1e300, 0},
+		{GenerateEmptyInterval[float64](), 1, math.Inf(1)},
+	}
+	for _, test := range tests {
+		AssertEqual(test.interval.Distance(test.Value), test.expected, t)
+	}
+	AssertTrue(math.IsNaN(GenerateClosedInterval(0.0, 5.0).Distance(math.NaN())), t)
+	AssertEqual(GenerateClosedInterval[uint8](10, 20).Distance(2), 8.0, t)
+}
+
+/* !SECTION: Clamp Testing */
@@ -0,0 +1,105 @@
+package interval
+
+import "math"
+
+/*
+	Public Boolean Function that returns true if two intervals hold the same Values.
+
+	Bounds are compared on the number line rather than field by field, so an UnboundedPoint and -∞ or +∞ are
+	equal, the Value of an infinite bound is ignored and two Empty Intervals are always equal.
+	Open and Closed endpoints are respected: (1,5) and [1,5] are not equal. Over an integer domain compare
+	the Canonical forms to treat (1,5) and [2,4] as equal.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		bool
+*/
+func Equal[N Numeric](a, b Interval[N]) bool {
+	return Compare(a, b) == 0
+}
+
+/*
+	Public Function that totally orders intervals, ex: for slices.SortFunc.
+
+	Intervals are ordered by LowerBound, then by UpperBound, where a Closed bound at a sorts before an Open
+	LowerBound at a and after an Open UpperBound at a (see CompareBounds). The Empty Interval sorts first.
+	Compare returns 0 exactly when Equal returns true.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		int	-1 if a sorts before b, 0 if they are Equal and +1 if a sorts after b
+*/
+func Compare[N Numeric](a, b Interval[N]) int {
+	aEmpty, bEmpty := isEmptyInterval(a), isEmptyInterval(b)
+	switch {
+	case aEmpty && bEmpty:
+		return 0
+	case aEmpty:
+		return -1
+	case bEmpty:
+		return 1
+	}
+	if order := CompareBounds(a.LowerBound.AsLower(), b.LowerBound.AsLower()); order != 0 {
+		return order
+	}
+	return CompareBounds(a.UpperBound.AsUpper(), b.UpperBound.AsUpper())
+}
+
+/* FNV-1a 64-bit parameters */
+const (
+	hashOffset uint64 = 14695981039346656037
+	hashPrime  uint64 = 1099511628211
+)
+
+/*
+	Public Method that returns a stable 64-bit FNV-1a hash of the interval. Equal intervals have the same Hash,
+	so Hash can key a map used to deduplicate intervals by membership. The Hash only depends on the bounds of
+	the interval on the number line and does not change between runs.
+
+	Return:
+		uint64
+*/
+func (self Interval[N]) Hash() uint64 {
+	hash := hashOffset
+	if isEmptyInterval(self) {
+		return hashWord(hash, 0)
+	}
+	hash = hashWord(hash, 1)
+	for _, bound := range []Bound[N]{self.LowerBound.AsLower(), self.UpperBound.AsUpper()} {
+		class, Value, offset := bound.position()
+		hash = hashWord(hash, uint64(class))
+		if class == 0 {
+			hash = hashWord(hash, hashValue(Value))
+			hash = hashWord(hash, uint64(offset))
+		}
+	}
+	return hash
+}
+
+/* Private function that returns the bits of a Value to hash. -0 and +0 are equal and share their bits. */
+func hashValue[N Numeric](Value N) uint64 {
+	if Value == 0 {
+		return 0
+	}
+	if isFloat[N]() {
+		return math.Float64bits(float64(Value))
+	}
+	if isUnsigned[N]() {
+		return uint64(Value)
+	}
+	return uint64(int64(Value))
+}
+
+/* Private function that folds the 8 bytes of a word into an FNV-1a hash */
+func hashWord(hash, word uint64) uint64 {
+	for i := 0; i < 8; i++ {
+		hash ^= word & 0xff
+		hash *= hashPrime
+		word >>= 8
+	}
+	return hash
+}
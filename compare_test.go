@@ -0,0 +1,109 @@
+package interval
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+/* SECTION: Comparison Testing */
+
+func TestEqual(t *testing.T) {
+	AssertTrue(Equal(GenerateClosedOpenInterval(1, 5), GenerateClosedOpenInterval(1, 5)), t)
+	AssertFalse(Equal(GenerateOpenInterval(1, 5), GenerateClosedInterval(1, 5)), t)
+	AssertFalse(Equal(GenerateOpenInterval(1, 5), GenerateClosedInterval(2, 4)), t)
+	AssertTrue(Equal(GenerateOpenInterval(1, 5).Canonical(), GenerateClosedInterval(2, 4).Canonical()), t)
+
+	/* Empty Intervals are equal whatever endpoints they were derived from */
+	AssertTrue(Equal(GenerateOpenInterval(2, 2), GenerateClosedOpenInterval(7, 7)), t)
+	AssertTrue(Equal(GenerateOpenInterval(2, 2), GenerateEmptyInterval[int]()), t)
+	AssertFalse(Equal(GenerateEmptyInterval[int](), GenerateClosedInterval(0, 0)), t)
+}
+
+func TestEqualUnbounded(t *testing.T) {
+	/* the legacy UnboundedPoint, with any Value, is the same bound as the explicit infinity of its side */
+	legacy := Interval[float64]{
+		LowerBound: Point[float64]{Value: math.Inf(-1), Type: UnboundedPoint},
+		UpperBound: Point[float64]{Value: 3, Type: ClosedPoint},
+		Type:       AtMostInterval,
+	}
+	AssertTrue(Equal(legacy, GenerateAtMostInterval(3.0)), t)
+	AssertEqual(legacy.Hash(), GenerateAtMostInterval(3.0).Hash(), t)
+
+	AssertTrue(Equal(GenerateUnboundedInterval[int](), GenerateUnboundedInterval[int]()), t)
+	AssertFalse(Equal(GenerateAtLeastInterval(3), GenerateGreaterThanInterval(3)), t)
+	AssertFalse(Equal(GenerateAtLeastInterval(3), GenerateUnboundedInterval[int]()), t)
+}
+
+func TestCompare(t *testing.T) {
+	intervals := []Interval[int]{
+		GenerateUnboundedInterval[int](),
+		GenerateOpenInterval(1, 5),
+		GenerateClosedInterval(1, 5),
+		GenerateAtLeastInterval(1),
+		GenerateClosedOpenInterval(1, 5),
+		GenerateEmptyInterval[int](),
+		GenerateOpenClosedInterval(1, 5),
+		GenerateAtMostInterval(0),
+		GenerateClosedInterval(1, 1),
+	}
+	slices.SortFunc(intervals, func(a, b Interval[int]) bool {
+		return Compare(a, b) < 0
+	})
+	expected := []string{"{}", "(-∞,0]", "(-∞,+∞)", "[1,1]", "[1,5)", "[1,5]", "[1,+∞)", "(1,5)", "(1,5]"}
+	for i, interval := range intervals {
+		AssertEqual(interval.String(), expected[i], t)
+	}
+
+	for _, a := range intervals {
+		for _, b := range intervals {
+			AssertEqual(Compare(a, b), -Compare(b, a), t)
+			AssertEqual(Compare(a, b) == 0, Equal(a, b), t)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	AssertEqual(GenerateClosedOpenInterval(1, 5).Hash(), GenerateClosedOpenInterval(1, 5).Hash(), t)
+	AssertEqual(GenerateOpenInterval(2, 2).Hash(), GenerateEmptyInterval[int]().Hash(), t)
+	AssertEqual(GenerateClosedInterval(math.Copysign(0, -1), 1.0).Hash(), GenerateClosedInterval(0.0, 1.0).Hash(), t)
+
+	distinct := []Interval[int]{
+		GenerateEmptyInterval[int](),
+		GenerateClosedInterval(0, 0),
+		GenerateOpenInterval(1, 5),
+		GenerateClosedInterval(1, 5),
+		GenerateClosedOpenInterval(1, 5),
+		GenerateOpenClosedInterval(1, 5),
+		GenerateAtLeastInterval(1),
+		GenerateGreaterThanInterval(1),
+		GenerateAtMostInterval(1),
+		GenerateLessThanInterval(1),
+		GenerateClosedInterval(-1, 1),
+		GenerateUnboundedInterval[int](),
+	}
+	hashes := map[uint64]bool{}
+	for _, interval := range distinct {
+		hashes[interval.Hash()] = true
+	}
+	AssertEqual(len(hashes), len(distinct), t)
+
+	/* the Hash is stable across runs */
+	AssertEqual(GenerateClosedOpenInterval(1, 5).Hash(), uint64(4966330868425575544), t)
+}
+
+func TestHashDeduplicate(t *testing.T) {
+	unique := map[uint64]Interval[int]{}
+	for _, interval := range []Interval[int]{
+		GenerateOpenInterval(1, 1),
+		GenerateClosedInterval(1, 3),
+		GenerateClosedOpenInterval(4, 4),
+		GenerateClosedInterval(1, 3),
+	} {
+		unique[interval.Hash()] = interval
+	}
+	AssertEqual(len(unique), 2, t)
+}
+
+/* !SECTION: Comparison Testing */
@@ -0,0 +1,320 @@
+package interval
+
+import "fmt"
+
+/* the number of constraint revisions Propagate makes before it stops short of a fixpoint */
+const maxPropagationSteps = 1 << 14
+
+/* Private Type for the operator of an Expression node */
+type operator int
+
+const (
+	variableOperator operator = iota
+	constantOperator
+	plusOperator
+	minusOperator
+	timesOperator
+)
+
+/*
+	Expression Type for an arithmetic expression over named variables, built from Variable and Constant with
+	Plus, Minus and Times, ex: Variable[int]("x").Plus(Variable[int]("y")) for x + y.
+*/
+type Expression[N Numeric] struct {
+	operator    operator
+	name        string
+	value       N
+	left, right *Expression[N]
+}
+
+/* Public Function that returns the Expression of the variable name */
+func Variable[N Numeric](name string) *Expression[N] {
+	return &Expression[N]{operator: variableOperator, name: name}
+}
+
+/* Public Function that returns the Expression of a constant Value */
+func Constant[N Numeric](Value N) *Expression[N] {
+	return &Expression[N]{operator: constantOperator, value: Value}
+}
+
+/* Public Method that returns the Expression self + other */
+func (self *Expression[N]) Plus(other *Expression[N]) *Expression[N] {
+	return &Expression[N]{operator: plusOperator, left: self, right: other}
+}
+
+/* Public Method that returns the Expression self - other */
+func (self *Expression[N]) Minus(other *Expression[N]) *Expression[N] {
+	return &Expression[N]{operator: minusOperator, left: self, right: other}
+}
+
+/* Public Method that returns the Expression self * other */
+func (self *Expression[N]) Times(other *Expression[N]) *Expression[N] {
+	return &Expression[N]{operator: timesOperator, left: self, right: other}
+}
+
+/* Public Method that returns the infix notation of the Expression, ex: (x + y) * 2 */
+func (self *Expression[N]) String() string {
+	switch self.operator {
+	case variableOperator:
+		return self.name
+	case constantOperator:
+		return fmt.Sprintf("%v", self.value)
+	}
+	symbol := map[operator]string{plusOperator: "+", minusOperator: "-", timesOperator: "*"}[self.operator]
+	left, right := self.left.String(), self.right.String()
+	if self.left.precedence() < self.precedence() {
+		left = "(" + left + ")"
+	}
+	/* a - (b + c) needs parentheses, a + (b - c) and a * (b * c) do not */
+	if self.right.precedence() < self.precedence() || (self.operator == minusOperator && self.right.precedence() == self.precedence()) {
+		right = "(" + right + ")"
+	}
+	return left + " " + symbol + " " + right
+}
+
+/* Private Method that returns how tightly the operator of the Expression binds its operands */
+func (self *Expression[N]) precedence() int {
+	switch self.operator {
+	case plusOperator, minusOperator:
+		return 1
+	case timesOperator:
+		return 2
+	}
+	return 3
+}
+
+/* Private Method that appends the names of the variables of the Expression, in order of appearance */
+func (self *Expression[N]) variables(names []string) []string {
+	switch self.operator {
+	case variableOperator:
+		for _, name := range names {
+			if name == self.name {
+				return names
+			}
+		}
+		return append(names, self.name)
+	case constantOperator:
+		return names
+	}
+	return self.right.variables(self.left.variables(names))
+}
+
+/*
+	Constraint Type for a relation between two Expressions, built with EqualTo, AtMost, LessThan, AtLeast or
+	GreaterThan, ex: Variable[int]("x").Times(Variable[int]("y")).AtMost(Constant(10)) for x * y <= 10.
+	It holds when left - right lies within its range: [0,0] for =, (-∞,0] for <= and so on.
+*/
+type Constraint[N Numeric] struct {
+	left, right *Expression[N]
+	relation    string
+	valid       Interval[N]
+}
+
+/* Private Method that returns the Constraint between the Expression and other whose difference lies in valid */
+func (self *Expression[N]) constraint(other *Expression[N], relation string, valid Interval[N]) Constraint[N] {
+	return Constraint[N]{left: self, right: other, relation: relation, valid: valid}
+}
+
+/* Public Method that returns the Constraint self = other */
+func (self *Expression[N]) EqualTo(other *Expression[N]) Constraint[N] {
+	return self.constraint(other, "=", GenerateClosedInterval[N](0, 0))
+}
+
+/* Public Method that returns the Constraint self <= other */
+func (self *Expression[N]) AtMost(other *Expression[N]) Constraint[N] {
+	return self.constraint(other, "<=", GenerateAtMostInterval[N](0))
+}
+
+/* Public Method that returns the Constraint self < other */
+func (self *Expression[N]) LessThan(other *Expression[N]) Constraint[N] {
+	return self.constraint(other, "<", GenerateLessThanInterval[N](0))
+}
+
+/* Public Method that returns the Constraint self >= other */
+func (self *Expression[N]) AtLeast(other *Expression[N]) Constraint[N] {
+	return self.constraint(other, ">=", GenerateAtLeastInterval[N](0))
+}
+
+/* Public Method that returns the Constraint self > other */
+func (self *Expression[N]) GreaterThan(other *Expression[N]) Constraint[N] {
+	return self.constraint(other, ">", GenerateGreaterThanInterval[N](0))
+}
+
+/* Public Method that returns the notation of the Constraint, ex: x * y <= 10 */
+func (self Constraint[N]) String() string {
+	return self.left.String() + " " + self.relation + " " + self.right.String()
+}
+
+/* Public Method that returns the names of the variables of the Constraint, in order of appearance */
+func (self Constraint[N]) Variables() []string {
+	return self.right.variables(self.left.variables(nil))
+}
+
+/*
+	Private function that returns the Values x with x * divisor in product: their hull, or (-∞,+∞) if both hold
+	0 since 0 * x = 0 for every x.
+*/
+func quotientHull[N Numeric](product, divisor Interval[N]) Interval[N] {
+	if product.Contains(0) && divisor.Contains(0) {
+		return GenerateUnboundedInterval[N]()
+	}
+	return Span(divIntervals(product, divisor, true).Intervals()...)
+}
+
+/* Private Type for one revision of a Constraint: the forward images of its nodes and the domains it narrows */
+type revision[N Numeric] struct {
+	domains map[string]Interval[N]
+	images  map[*Expression[N]]Interval[N]
+	changed map[string]bool
+}
+
+/* Private Method that returns the domain of a variable, (-∞,+∞) if it has none */
+func (self *revision[N]) domain(name string) Interval[N] {
+	if domain, ok := self.domains[name]; ok {
+		return domain
+	}
+	return GenerateUnboundedInterval[N]()
+}
+
+/*
+	Private Method that evaluates an Expression over the domains, bottom up, recording the image of each node.
+	Float images are rounded outwards so that they enclose every Value of the node.
+*/
+func (self *revision[N]) forward(expression *Expression[N]) Interval[N] {
+	var image Interval[N]
+	switch expression.operator {
+	case variableOperator:
+		image = self.domain(expression.name)
+	case constantOperator:
+		image = GenerateClosedInterval(expression.value, expression.value)
+	case plusOperator:
+		image = addIntervals(self.forward(expression.left), self.forward(expression.right), true)
+	case minusOperator:
+		image = subIntervals(self.forward(expression.left), self.forward(expression.right), true)
+	case timesOperator:
+		image = mulIntervals(self.forward(expression.left), self.forward(expression.right), true)
+	}
+	self.images[expression] = image
+	return image
+}
+
+/*
+	Private Method that narrows an Expression to the Values within target, top down: each node keeps the part
+	of its image within target and projects it onto its operands. It returns the name of the variable whose
+	domain it emptied, or ok false with an empty name if the node itself cannot reach target.
+*/
+func (self *revision[N]) backward(expression *Expression[N], target Interval[N]) (emptied string, ok bool) {
+	target = Intersect(self.images[expression], target)
+	if expression.operator == variableOperator {
+		/* integer domains are kept Canonical, so that domains with the same members are == */
+		target = target.Canonical()
+	}
+	if target.Type == EmptyInterval {
+		if expression.operator == variableOperator {
+			return expression.name, false
+		}
+		return "", false
+	}
+	left, right := self.images[expression.left], self.images[expression.right]
+	switch expression.operator {
+	case variableOperator:
+		if !Equal(target, self.domain(expression.name)) {
+			self.domains[expression.name] = target
+			self.changed[expression.name] = true
+		}
+		return "", true
+	case constantOperator:
+		return "", true
+	case plusOperator:
+		/* x + y = t gives x = t - y and y = t - x */
+		left, right = subIntervals(target, right, true), subIntervals(target, left, true)
+	case minusOperator:
+		/* x - y = t gives x = t + y and y = x - t */
+		left, right = addIntervals(target, right, true), subIntervals(left, target, true)
+	case timesOperator:
+		/* x * y = t gives x = t / y and y = t / x */
+		left, right = quotientHull(target, right), quotientHull(target, left)
+	}
+	if emptied, ok := self.backward(expression.left, left); !ok {
+		return emptied, false
+	}
+	/* the left operand may share variables with the right one, so the right one is evaluated again */
+	self.forward(expression.right)
+	return self.backward(expression.right, right)
+}
+
+/*
+	Private Method that revises the domains with a Constraint (HC4Revise): a forward evaluation of left - right,
+	then a backward projection of the valid range onto its variables.
+*/
+func (self *revision[N]) revise(constraint Constraint[N]) (emptied string, ok bool) {
+	root := constraint.left.Minus(constraint.right)
+	self.forward(root)
+	return self.backward(root, constraint.valid)
+}
+
+/*
+	Public Function that narrows the domains of variables with constraints, by forward-backward contraction
+	(HC4) propagated to a fixpoint: a Constraint is revised again whenever the domain of one of its variables
+	narrows. Only Values that cannot satisfy a Constraint are removed, so every solution within the domains
+	is within the narrowed domains. A variable without a domain starts as (-∞,+∞).
+
+	Integer domains are returned in Canonical form: x < y over [0,5] × [0,5] narrows x to [0,4] and y to [1,5].
+	Float domains narrowing ever more slowly are stopped after maxPropagationSteps revisions, short of the
+	fixpoint but with every solution still within them.
+
+	Parameters:
+		domains map[string]Interval[N]	the domains are not modified
+		constraints ...Constraint[N]
+	Return:
+		map[string]Interval[N]	the narrowed domains of every variable, including those without a domain
+		error	a *EmptyDomainError[N] if a Constraint cannot be satisfied within the domains
+*/
+func Propagate[N Numeric](domains map[string]Interval[N], constraints ...Constraint[N]) (map[string]Interval[N], error) {
+	narrowed := make(map[string]Interval[N], len(domains))
+	for name, domain := range domains {
+		narrowed[name] = domain.Canonical()
+	}
+	watchers := map[string][]int{}
+	var err error
+	for i, constraint := range constraints {
+		for _, name := range constraint.Variables() {
+			domain, ok := narrowed[name]
+			if !ok {
+				narrowed[name] = GenerateUnboundedInterval[N]()
+			}
+			if ok && domain.Type == EmptyInterval && err == nil {
+				err = &EmptyDomainError[N]{Constraint: constraint, Variable: name}
+			}
+			watchers[name] = append(watchers[name], i)
+		}
+	}
+	if err != nil {
+		return narrowed, err
+	}
+
+	queue := make([]int, len(constraints))
+	queued := make([]bool, len(constraints))
+	for i := range constraints {
+		queue[i], queued[i] = i, true
+	}
+	for steps := 0; len(queue) > 0 && steps < maxPropagationSteps; steps++ {
+		next := queue[0]
+		queue, queued[next] = queue[1:], false
+		step := revision[N]{domains: narrowed, images: map[*Expression[N]]Interval[N]{}, changed: map[string]bool{}}
+		if emptied, ok := step.revise(constraints[next]); !ok {
+			if emptied != "" {
+				narrowed[emptied] = GenerateEmptyInterval[N]()
+			}
+			return narrowed, &EmptyDomainError[N]{Constraint: constraints[next], Variable: emptied}
+		}
+		for name := range step.changed {
+			for _, i := range watchers[name] {
+				if !queued[i] {
+					queue, queued[i] = append(queue, i), true
+				}
+			}
+		}
+	}
+	return narrowed, nil
+}
@@ -0,0 +1,121 @@
+package interval
+
+import (
+	"errors"
+	"testing"
+)
+
+/* SECTION: Contractor Testing */
+
+func TestExpressionString(t *testing.T) {
+	x, y, z := Variable[int]("x"), Variable[int]("y"), Variable[int]("z")
+	AssertEqual(x.Plus(y).Times(Constant(2)).String(), "(x + y) * 2", t)
+	AssertEqual(x.Minus(y.Minus(z)).String(), "x - (y - z)", t)
+	AssertEqual(x.Minus(y).Minus(z).String(), "x - y - z", t)
+	AssertEqual(x.Plus(y.Times(z)).String(), "x + y * z", t)
+	AssertEqual(x.Plus(y.Minus(z)).String(), "x + y - z", t)
+	AssertEqual(x.Times(y).AtMost(Constant(10)).String(), "x * y <= 10", t)
+	AssertEqual(x.Plus(y).EqualTo(z).String(), "x + y = z", t)
+	AssertEqualSlice(x.Times(x.Plus(y)).GreaterThan(z).Variables(), []string{"x", "y", "z"}, t)
+}
+
+func TestPropagate(t *testing.T) {
+	x, y, z := Variable[int]("x"), Variable[int]("y"), Variable[int]("z")
+	tests := []struct {
+		domains     map[string]Interval[int]
+		constraints []Constraint[int]
+		expected    map[string]string
+	}{
+		{
+			map[string]Interval[int]{"x": GenerateClosedInterval(0, 10), "y": GenerateClosedInterval(0, 10), "z": GenerateClosedInterval(0, 5)},
+			[]Constraint[int]{x.Plus(y).EqualTo(z)},
+			map[string]string{"x": "[0,5]", "y": "[0,5]", "z": "[0,5]"},
+		},
+		{
+			map[string]Interval[int]{"x": GenerateClosedInterval(2, 10), "y": GenerateClosedInterval(3, 10)},
+			[]Constraint[int]{x.Times(y).AtMost(Constant(10))},
+			map[string]string{"x": "[2,3]", "y": "[3,5]"},
+		},
+		{
+			/* narrowing z through the second constraint revises the first one again */
+			map[string]Interval[int]{"x": GenerateClosedInterval(0, 100), "z": GenerateClosedInterval(0, 2)},
+			[]Constraint[int]{x.EqualTo(y.Plus(Constant(1))), y.EqualTo(z.Plus(Constant(1))), x.AtMost(Constant(3))},
+			map[string]string{"x": "[2,3]", "y": "[1,2]", "z": "[0,1]"},
+		},
+		{
+			map[string]Interval[int]{"x": GenerateClosedInterval(0, 5), "y": GenerateClosedInterval(0, 5)},
+			[]Constraint[int]{x.LessThan(y)},
+			map[string]string{"x": "[0,4]", "y": "[1,5]"},
+		},
+		{
+			/* integer domains are returned Canonical */
+			map[string]Interval[int]{"x": GenerateOpenInterval(0, 5)},
+			[]Constraint[int]{x.AtLeast(Constant(0))},
+			map[string]string{"x": "[1,4]"},
+		},
+		{
+			map[string]Interval[int]{"x": GenerateClosedInterval(0, 10), "y": GenerateClosedInterval(0, 10)},
+			[]Constraint[int]{x.Plus(y).EqualTo(z)},
+			map[string]string{"x": "[0,10]", "y": "[0,10]", "z": "[0,20]"},
+		},
+	}
+	for _, test := range tests {
+		narrowed, err := Propagate(test.domains, test.constraints...)
+		AssertTrue(err == nil, t)
+		AssertEqual(len(narrowed), len(test.expected), t)
+		for name, expected := range test.expected {
+			AssertEqual(narrowed[name].String(), expected, t)
+		}
+	}
+}
+
+func TestPropagateCanonical(t *testing.T) {
+	x, y := Variable[int]("x"), Variable[int]("y")
+	domains := map[string]Interval[int]{"x": GenerateClosedInterval(0, 5), "y": GenerateClosedInterval(0, 5)}
+	narrowed, err := Propagate(domains, x.LessThan(y))
+	AssertTrue(err == nil, t)
+	AssertTrue(narrowed["x"] == GenerateClosedOpenInterval(0, 5).Canonical(), t)
+	AssertTrue(narrowed["y"] == GenerateOpenClosedInterval(0, 5).Canonical(), t)
+	AssertEqual(narrowed["x"].Hash(), GenerateClosedInterval(0, 4).Hash(), t)
+}
+
+func TestPropagateFloat(t *testing.T) {
+	x, y, z := Variable[float64]("x"), Variable[float64]("y"), Variable[float64]("z")
+	domains := map[string]Interval[float64]{"x": GenerateClosedInterval(1.0, 2.0), "y": GenerateClosedInterval(3.0, 4.0)}
+	narrowed, err := Propagate(domains, z.EqualTo(x.Times(y)), z.AtMost(Constant(4.0)))
+	AssertTrue(err == nil, t)
+	AssertTrue(narrowed["x"].ContainsInterval(GenerateClosedInterval(1.0, 4.0/3)), t)
+	AssertTrue(narrowed["x"].UpperBound.Value < 1.34, t)
+	AssertEqual(narrowed["y"].String(), "[3,4]", t)
+	AssertEqual(narrowed["z"].String(), "[3,4]", t)
+
+	/* the domains given are not modified */
+	AssertEqual(domains["x"].String(), "[1,2]", t)
+	AssertEqual(len(domains), 2, t)
+}
+
+func TestPropagateEmptyDomain(t *testing.T) {
+	x, y, z := Variable[int]("x"), Variable[int]("y"), Variable[int]("z")
+	infeasible := x.Plus(y).EqualTo(z)
+	_, err := Propagate(map[string]Interval[int]{
+		"x": GenerateClosedInterval(5, 6), "y": GenerateClosedInterval(5, 6), "z": GenerateClosedInterval(0, 5),
+	}, x.AtMost(Constant(6)), infeasible)
+	var empty *EmptyDomainError[int]
+	AssertTrue(errors.As(err, &empty), t)
+	AssertEqual(empty.Constraint.String(), "x + y = z", t)
+	AssertEqual(empty.Variable, "", t)
+	AssertEqual(err.Error(), "constraint x + y = z cannot be satisfied", t)
+
+	/* 2x = 3 has no integer solution, which empties x */
+	narrowed, err := Propagate(map[string]Interval[int]{"x": GenerateClosedInterval(0, 10)}, Constant(2).Times(x).EqualTo(Constant(3)))
+	AssertTrue(errors.As(err, &empty), t)
+	AssertEqual(empty.Variable, "x", t)
+	AssertEqual(narrowed["x"].Type, EmptyInterval, t)
+	AssertEqual(err.Error(), "constraint 2 * x = 3 empties the domain of x", t)
+
+	_, err = Propagate(map[string]Interval[int]{"x": GenerateEmptyInterval[int]()}, x.AtLeast(Constant(0)))
+	AssertTrue(errors.As(err, &empty), t)
+	AssertEqual(empty.Variable, "x", t)
+}
+
+/* !SECTION: Contractor Testing */
@@ -0,0 +1,74 @@
+package interval
+
+/*
+	Public Method that returns the canonical form of an interval over an integer type: every finite endpoint
+	is Closed, so (1,5), [2,5) and [2,4] all become [2,4] and (2,+∞) becomes [3,+∞). Intervals with the same
+	members then have the same Type, bounds and String() and can be compared with ==. An integer interval
+	holding no integer, ex: (1,2), becomes the Empty Interval.
+
+	The closed form is used rather than the closed-open one since [a,b) cannot hold the largest Value of N.
+
+	Float intervals are returned unchanged: their Open and Closed endpoints describe different sets.
+
+	Return:
+		Interval[N]
+*/
+func (self Interval[N]) Canonical() Interval[N] {
+	if isFloat[N]() {
+		return self
+	}
+	if self.Type == EmptyInterval {
+		return GenerateEmptyInterval[N]()
+	}
+	start, end := self.LowerBound, self.UpperBound
+	if start.Type == OpenPoint {
+		/* (max,…) holds no integer of N */
+		if start.Value+1 < start.Value {
+			return GenerateEmptyInterval[N]()
+		}
+		start = Point[N]{Value: start.Value + 1, Type: ClosedPoint}
+	}
+	if end.Type == OpenPoint {
+		/* (…,min) holds no integer of N */
+		if end.Value-1 > end.Value {
+			return GenerateEmptyInterval[N]()
+		}
+		end = Point[N]{Value: end.Value - 1, Type: ClosedPoint}
+	}
+	if !start.IsInfinite() && !end.IsInfinite() && start.Value > end.Value {
+		return GenerateEmptyInterval[N]()
+	}
+	return GenerateInterval(start, end)
+}
+
+/*
+	Public Construction Function to generate an interval set over an integer domain.
+
+	A discrete set stores its intervals in Canonical form and also coalesces intervals that are adjacent over
+	the integers, so [1,3] and [4,6] are stored as [1,6]. For float types a discrete set behaves like one
+	built by NewIntervalSet.
+
+	Parameters:
+		intervals ...Interval[N]	intervals to add to the set.
+	Return:
+		IntervalSet[N] IntervalSet Struct
+*/
+func NewDiscreteIntervalSet[N Numeric](intervals ...Interval[N]) IntervalSet[N] {
+	set := IntervalSet[N]{discrete: !isFloat[N]()}
+	for _, interval := range intervals {
+		set.Add(interval)
+	}
+	return set
+}
+
+/*
+	Private Boolean function that returns true if the Closed UpperBound u and the Closed LowerBound l of two
+	Canonical integer intervals are consecutive integers, i.e. if [a,u] ∪ [l,b] = [a,b].
+*/
+func discreteTouch[N Numeric](u, l Point[N]) bool {
+	if u.Type != ClosedPoint || l.Type != ClosedPoint {
+		return false
+	}
+	/* u+1 wraps around when u is the largest Value of N */
+	return u.Value+1 > u.Value && u.Value+1 == l.Value
+}
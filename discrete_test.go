@@ -0,0 +1,90 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+/* SECTION: Discrete Testing */
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		interval Interval[int]
+		expected string
+	}{
+		{GenerateOpenInterval(1, 5), "[2,4]"},
+		{GenerateClosedOpenInterval(2, 5), "[2,4]"},
+		{GenerateOpenClosedInterval(1, 4), "[2,4]"},
+		{GenerateClosedInterval(2, 4), "[2,4]"},
+		{GenerateOpenInterval(1, 3), "[2,2]"},
+		{GenerateOpenInterval(1, 2), "{}"},
+		{GenerateGreaterThanInterval(2), "[3,+∞)"},
+		{GenerateLessThanInterval(5), "(-∞,4]"},
+		{GenerateUnboundedInterval[int](), "(-∞,+∞)"},
+		{GenerateGreaterThanInterval(math.MaxInt), "{}"},
+		{GenerateLessThanInterval(math.MinInt), "{}"},
+	}
+	for _, test := range tests {
+		canonical := test.interval.Canonical()
+		AssertEqual(canonical.String(), test.expected, t)
+	}
+	AssertTrue(GenerateOpenInterval(1, 5).Canonical() == GenerateClosedInterval(2, 4).Canonical(), t)
+	AssertTrue(GenerateOpenInterval(1, 2).Canonical() == GenerateOpenInterval(7, 8).Canonical(), t)
+}
+
+func TestCanonicalKeepsMembers(t *testing.T) {
+	for _, interval := range []Interval[int8]{
+		GenerateOpenInterval[int8](-128, 127),
+		GenerateClosedOpenInterval[int8](-5, 5),
+		GenerateOpenClosedInterval[int8](-5, 5),
+	} {
+		canonical := interval.Canonical()
+		AssertEqual(canonical.Count(), interval.Count(), t)
+		for Value := -128; Value <= 127; Value++ {
+			AssertEqual(canonical.Contains(int8(Value)), interval.Contains(int8(Value)), t)
+		}
+	}
+}
+
+func TestCanonicalFloat(t *testing.T) {
+	interval := GenerateOpenInterval(1.0, 5.0)
+	AssertEqual(interval.Canonical(), interval, t)
+}
+
+func TestDiscreteIntervalSet(t *testing.T) {
+	set := NewDiscreteIntervalSet(GenerateClosedInterval(1, 3), GenerateClosedInterval(4, 6))
+	AssertEqual(set.String(), "[1,6]", t)
+
+	set = NewDiscreteIntervalSet(GenerateOpenInterval(0, 4), GenerateClosedOpenInterval(4, 8), GenerateOpenInterval(9, 12))
+	AssertEqual(set.String(), "[1,7] ∪ [10,11]", t)
+
+	set.Add(GenerateOpenClosedInterval(7, 9))
+	AssertEqual(set.String(), "[1,11]", t)
+
+	set.Add(GenerateOpenInterval(20, 21))
+	AssertEqual(set.String(), "[1,11]", t)
+
+	set.Remove(GenerateOpenInterval(3, 6))
+	AssertEqual(set.String(), "[1,3] ∪ [6,11]", t)
+
+	set.Remove(GenerateClosedInterval(4, 5))
+	AssertEqual(set.String(), "[1,3] ∪ [6,11]", t)
+
+	/* the same intervals are not coalesced outside the discrete mode */
+	AssertEqual(NewIntervalSet(GenerateClosedInterval(1, 3), GenerateClosedInterval(4, 6)).String(), "[1,3] ∪ [4,6]", t)
+}
+
+func TestDiscreteIntervalSetAtTypeLimits(t *testing.T) {
+	set := NewDiscreteIntervalSet(GenerateClosedInterval[uint8](250, 255), GenerateClosedInterval[uint8](0, 3))
+	AssertEqual(set.String(), "[0,3] ∪ [250,255]", t)
+
+	set.Add(GenerateGreaterThanInterval[uint8](255))
+	AssertEqual(set.String(), "[0,3] ∪ [250,255]", t)
+}
+
+func TestDiscreteIntervalSetFloat(t *testing.T) {
+	set := NewDiscreteIntervalSet(GenerateClosedInterval(1.0, 3.0), GenerateClosedInterval(4.0, 6.0))
+	AssertEqual(set.String(), "[1,3] ∪ [4,6]", t)
+}
+
+/* !SECTION: Discrete Testing */
@@ -0,0 +1,263 @@
+package interval
+
+import "math"
+
+/* SECTION: Private Elementary Function Helpers */
+
+/* below tinySquare the residual of a square root may underflow to 0, so a 0 residual no longer proves it exact */
+const tinySquare = 0x1p-960
+
+/*
+	Private function that returns the candidate of f at an endpoint, from the side of the bound it is on.
+	An infinite endpoint gives the limit of f, Open since it is never attained. rounding returns the sign of
+	the rounding error of y = f(x), see roundedCandidate.
+*/
+func imageCandidate(point Point[float64], side int, f func(float64) float64, rounding func(x, y float64) int) candidate[float64] {
+	x := point.Value
+	if point.IsInfinite() {
+		x = math.Inf(side)
+	}
+	y := f(x)
+	switch {
+	case isInf(y) && y > 0:
+		return infiniteCandidate[float64](1)
+	case isInf(y):
+		return infiniteCandidate[float64](-1)
+	case isInf(x):
+		return exactCandidate(y, true)
+	}
+	return roundedCandidate(y, point.Type == OpenPoint, rounding(x, y))
+}
+
+/*
+	Private function that returns the image of an interval under an increasing function f: the interval is
+	intersected with the domain of f, its endpoints are mapped and the result is intersected with the range of f.
+*/
+func increasingImage(interval, domain, codomain Interval[float64], f func(float64) float64, rounding func(x, y float64) int) Interval[float64] {
+	interval = Intersect(interval, domain)
+	if interval.Type == EmptyInterval {
+		return GenerateEmptyInterval[float64]()
+	}
+	return Intersect(candidateInterval([]candidate[float64]{
+		imageCandidate(interval.LowerBound, -1, f, rounding),
+		imageCandidate(interval.UpperBound, 1, f, rounding),
+	}), codomain)
+}
+
+/*
+	Private Boolean function that returns true if [lower,upper] may hold a Value phase + 2kπ. The test is
+	loosened by the rounding error of phase + 2kπ, so a Value just outside may be reported as within.
+*/
+func holdsPhase(lower, upper, phase float64) bool {
+	slack := 1e-15 * Max(1, math.Abs(lower), math.Abs(upper))
+	k := math.Ceil((lower - phase) / (2 * math.Pi))
+	for _, turn := range []float64{k - 1, k} {
+		Value := phase + turn*2*math.Pi
+		if Value >= lower-slack && Value <= upper+slack {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+	Private function that returns the image of an interval under a 2π-periodic function f that rises to 1 at
+	peak + 2kπ, falls to -1 at peak + π + 2kπ and is monotone in between, such as Sin and Cos.
+*/
+func periodicImage(interval Interval[float64], peak float64, f func(float64) float64, rounding func(x, y float64) int) Interval[float64] {
+	codomain := GenerateClosedInterval(-1.0, 1.0)
+	lower, upper := interval.LowerBound, interval.UpperBound
+	switch {
+	case interval.Type == EmptyInterval:
+		return GenerateEmptyInterval[float64]()
+	case lower.IsInfinite() || upper.IsInfinite() || upper.Value-lower.Value >= 2*math.Pi:
+		return codomain
+	}
+	candidates := []candidate[float64]{imageCandidate(lower, -1, f, rounding), imageCandidate(upper, 1, f, rounding)}
+	if holdsPhase(lower.Value, upper.Value, peak) {
+		candidates = append(candidates, exactCandidate(1.0, false))
+	}
+	if holdsPhase(lower.Value, upper.Value, peak+math.Pi) {
+		candidates = append(candidates, exactCandidate(-1.0, false))
+	}
+	return Intersect(candidateInterval(candidates), codomain)
+}
+
+/* Private function that returns the rounding of an f(x) that is only exact at x = exact, see roundedCandidate */
+func exactAt(exact float64) func(x, y float64) int {
+	return func(x, y float64) int {
+		if x == exact {
+			return 0
+		}
+		return unknownRounding
+	}
+}
+
+/* Private function that returns the rounding of y = √x, the sign of its residual x - y² */
+func sqrtRounding(x, y float64) int {
+	if x != 0 && x < tinySquare {
+		return unknownRounding
+	}
+	return signOf(math.FMA(-y, y, x))
+}
+
+/* Private function that returns x^n for a nonnegative interval, by squaring */
+func nonNegativePow(interval Interval[float64], n uint) Interval[float64] {
+	power := GenerateClosedInterval(1.0, 1.0)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			power = OutwardMul(power, interval)
+		}
+		if n > 1 {
+			interval = OutwardMul(interval, interval)
+		}
+	}
+	return power
+}
+
+/* !SECTION: Private Elementary Function Helpers */
+
+/* SECTION: Elementary Interval Functions */
+
+/*
+	The elementary functions return enclosures of the image of a float64 interval: every f(x) for x in the
+	interval. The math package results they are built on are assumed to lie within one float of the exact
+	Value, so inexact endpoints are widened by one float outwards. Values outside the domain of a function
+	are dropped by intersecting the interval with the domain, ex: Log((-1,4]) is the Log of (0,4].
+*/
+
+/*
+	Public Interval Function that returns the square root of an interval, √[a,b] = [√a,√b] for a ≥ 0. Square
+	roots are correctly rounded, so exact roots are kept: Sqrt([4,9]) = [2,3].
+
+	Parameters:
+		interval Interval[float64]
+	Return:
+		c Interval[float64]	Empty if the interval holds no Value ≥ 0
+*/
+func Sqrt(interval Interval[float64]) Interval[float64] {
+	domain := GenerateAtLeastInterval(0.0)
+	return increasingImage(interval, domain, domain, math.Sqrt, sqrtRounding)
+}
+
+/*
+	Public Interval Function that returns the exponential of an interval, e^[a,b] = [e^a,e^b], always within
+	(0,+∞) even where e^a underflows to 0.
+
+	Parameters:
+		interval Interval[float64]
+	Return:
+		c Interval[float64]
+*/
+func Exp(interval Interval[float64]) Interval[float64] {
+	return increasingImage(interval, GenerateUnboundedInterval[float64](), GenerateGreaterThanInterval(0.0), math.Exp, exactAt(0))
+}
+
+/*
+	Public Interval Function that returns the natural logarithm of an interval, ln [a,b] = [ln a,ln b] for
+	a > 0. An interval reaching 0 has a LowerBound of -∞: Log([0,1]) = (-∞,0].
+
+	Parameters:
+		interval Interval[float64]
+	Return:
+		c Interval[float64]	Empty if the interval holds no Value > 0
+*/
+func Log(interval Interval[float64]) Interval[float64] {
+	everything := GenerateUnboundedInterval[float64]()
+	return increasingImage(interval, GenerateGreaterThanInterval(0.0), everything, math.Log, exactAt(1))
+}
+
+/*
+	Public Interval Function that returns the absolute Value of an interval: the hull of its nonnegative part
+	and its negated negative part, ex: Abs([-3,2)) = [0,3].
+
+	Parameters:
+		interval Interval[float64]
+	Return:
+		c Interval[float64]	Empty if the interval is Empty
+*/
+func Abs(interval Interval[float64]) Interval[float64] {
+	positive := Intersect(interval, GenerateAtLeastInterval(0.0))
+	negative := Intersect(interval, GenerateAtMostInterval(0.0))
+	if negative.Type == EmptyInterval {
+		return positive
+	}
+	return Hull(positive, Neg(negative))
+}
+
+/*
+	Public Interval Function that returns the n-th power of an interval: every x^n for x in the interval, with
+	x^0 = 1. Even powers account for the minimum at 0, so Pow([-2,1], 2) = [0,4] rather than the [-2,4] of
+	Mul([-2,1], [-2,1]). A negative n gives the reciprocal of the power; where the interval holds 0 the result
+	is the hull of both sides, ex: Pow([-1,2], -1) = (-∞,+∞).
+
+	Parameters:
+		interval Interval[float64]
+		n int
+	Return:
+		c Interval[float64]	Empty if the interval is Empty
+*/
+func Pow(interval Interval[float64], n int) Interval[float64] {
+	if interval.Type == EmptyInterval {
+		return GenerateEmptyInterval[float64]()
+	}
+	if n == 0 {
+		return GenerateClosedInterval(1.0, 1.0)
+	}
+	/* -n overflows for math.MinInt, whose magnitude only fits in a uint */
+	exponent := uint(n)
+	if n < 0 {
+		exponent = uint(-(n + 1)) + 1
+	}
+	power := func(part Interval[float64]) Interval[float64] {
+		if part.Type == EmptyInterval {
+			return part
+		}
+		part = nonNegativePow(part, exponent)
+		if n > 0 {
+			return part
+		}
+		if reciprocal := OutwardDiv(GenerateClosedInterval(1.0, 1.0), part).Intervals(); len(reciprocal) > 0 {
+			return reciprocal[0]
+		}
+		return GenerateEmptyInterval[float64]()
+	}
+	positive := power(Intersect(interval, GenerateAtLeastInterval(0.0)))
+	negative := Intersect(interval, GenerateAtMostInterval(0.0))
+	if negative.Type == EmptyInterval {
+		return positive
+	}
+	negative = power(Neg(negative))
+	if exponent%2 == 1 && negative.Type != EmptyInterval {
+		negative = Neg(negative)
+	}
+	return Hull(positive, negative)
+}
+
+/*
+	Public Interval Function that returns the sine of an interval, accounting for the maxima at π/2 + 2kπ and
+	the minima at -π/2 + 2kπ within it. An interval at least 2π wide gives [-1,1].
+
+	Parameters:
+		interval Interval[float64]
+	Return:
+		c Interval[float64]	Empty if the interval is Empty
+*/
+func Sin(interval Interval[float64]) Interval[float64] {
+	return periodicImage(interval, math.Pi/2, math.Sin, exactAt(0))
+}
+
+/*
+	Public Interval Function that returns the cosine of an interval, accounting for the maxima at 2kπ and the
+	minima at π + 2kπ within it. An interval at least 2π wide gives [-1,1].
+
+	Parameters:
+		interval Interval[float64]
+	Return:
+		c Interval[float64]	Empty if the interval is Empty
+*/
+func Cos(interval Interval[float64]) Interval[float64] {
+	return periodicImage(interval, 0, math.Cos, exactAt(0))
+}
+
+/* !SECTION: Elementary Interval Functions */
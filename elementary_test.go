@@ -0,0 +1,121 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+/* SECTION: Elementary Function Testing */
+
+func TestMonotoneFunctions(t *testing.T) {
+	tests := []struct {
+		result   Interval[float64]
+		expected string
+	}{
+		{Sqrt(GenerateClosedInterval(4.0, 9.0)), "[2,3]"},
+		{Sqrt(GenerateOpenClosedInterval(4.0, 9.0)), "(2,3]"},
+		{Sqrt(GenerateClosedInterval(-4.0, 2.0)), "[0,1.4142135623730951]"},
+		{Sqrt(GenerateAtLeastInterval(1.0)), "[1,+∞)"},
+		{Sqrt(GenerateOpenInterval(-4.0, 0.0)), "{}"},
+		{Exp(GenerateClosedInterval(0.0, 1.0)), "[1,2.7182818284590455]"},
+		{Exp(GenerateUnboundedInterval[float64]()), "(0,+∞)"},
+		{Exp(GenerateAtMostInterval(-1000.0)), "(0,5e-324]"},
+		{Log(GenerateClosedInterval(1.0, math.E)), "[0,1.0000000000000002]"},
+		{Log(GenerateClosedInterval(0.0, 1.0)), "(-∞,0]"},
+		{Log(GenerateOpenClosedInterval(-1.0, 1.0)), "(-∞,0]"},
+		{Log(GenerateClosedInterval(-1.0, 0.0)), "{}"},
+		{Log(GenerateEmptyInterval[float64]()), "{}"},
+	}
+	for _, test := range tests {
+		AssertEqual(test.result.String(), test.expected, t)
+	}
+}
+
+func TestAbsAndPow(t *testing.T) {
+	tests := []struct {
+		result   Interval[float64]
+		expected string
+	}{
+		{Abs(GenerateClosedOpenInterval(-3.0, 2.0)), "[0,3]"},
+		{Abs(GenerateOpenInterval(-3.0, 4.0)), "[0,4)"},
+		{Abs(GenerateOpenInterval(-3.0, -2.0)), "(2,3)"},
+		{Abs(GenerateAtMostInterval(1.0)), "[0,+∞)"},
+		{Abs(GenerateEmptyInterval[float64]()), "{}"},
+		{Pow(GenerateClosedInterval(-2.0, 1.0), 2), "[0,4]"},
+		{Pow(GenerateClosedInterval(-2.0, 1.0), 3), "[-8,1]"},
+		{Pow(GenerateOpenInterval(-2.0, -1.0), 2), "(1,4)"},
+		{Pow(GenerateClosedInterval(-2.0, 1.0), 0), "[1,1]"},
+		{Pow(GenerateClosedInterval(2.0, 4.0), -2), "[0.0625,0.25]"},
+		{Pow(GenerateOpenInterval(-2.0, -1.0), -1), "(-1,-0.5)"},
+		{Pow(GenerateClosedInterval(-1.0, 2.0), -1), "(-∞,+∞)"},
+		{Pow(GenerateClosedInterval(0.0, 2.0), -2), "[0.25,+∞)"},
+		{Pow(GenerateEmptyInterval[float64](), 2), "{}"},
+		{Pow(GenerateClosedInterval(-1.0, 1.0), math.MinInt), "[1,+∞)"},
+		{Pow(GenerateClosedInterval(0.5, 2.0), math.MinInt), "(0,+∞)"},
+	}
+	for _, test := range tests {
+		AssertEqual(test.result.String(), test.expected, t)
+	}
+}
+
+func TestPeriodicFunctions(t *testing.T) {
+	tests := []struct {
+		result   Interval[float64]
+		expected string
+	}{
+		{Sin(GenerateClosedInterval(0.0, 1.0)), "[0,0.8414709848078966]"},
+		{Sin(GenerateClosedInterval(0.0, math.Pi)), "[0,1]"},
+		{Sin(GenerateClosedInterval(4.0, 5.0)), "[-1,-0.7568024953079281]"},
+		{Cos(GenerateClosedInterval(0.0, math.Pi)), "[-1,1]"},
+		{Cos(GenerateOpenInterval(1.0, 2.0)), "(-0.41614683654714246,0.5403023058681399)"},
+		{Sin(GenerateClosedInterval(0.0, 10.0)), "[-1,1]"},
+		{Cos(GenerateAtLeastInterval(1.0)), "[-1,1]"},
+		{Cos(GenerateEmptyInterval[float64]()), "{}"},
+	}
+	for _, test := range tests {
+		AssertEqual(test.result.String(), test.expected, t)
+	}
+}
+
+/* Private helper that returns x^math.MinInt, NaN where it underflows to 0 rather than the positive exact Value */
+func minIntPow(x float64) float64 {
+	if y := math.Pow(x, math.MinInt); y != 0 {
+		return y
+	}
+	return math.NaN()
+}
+
+func TestElementaryEncloses(t *testing.T) {
+	functions := []struct {
+		image func(Interval[float64]) Interval[float64]
+		f     func(float64) float64
+	}{
+		{Sqrt, math.Sqrt},
+		{Exp, math.Exp},
+		{Log, math.Log},
+		{Abs, math.Abs},
+		{Sin, math.Sin},
+		{Cos, math.Cos},
+		{func(x Interval[float64]) Interval[float64] { return Pow(x, 2) }, func(x float64) float64 { return x * x }},
+		{func(x Interval[float64]) Interval[float64] { return Pow(x, -3) }, func(x float64) float64 { return 1 / (x * x * x) }},
+		{func(x Interval[float64]) Interval[float64] { return Pow(x, math.MinInt) }, minIntPow},
+	}
+	failures := 0
+	for _, function := range functions {
+		for lower := -6.0; lower <= 6.0; lower += 0.75 {
+			for upper := lower; upper <= lower+7; upper += 1.25 {
+				interval := GenerateClosedInterval(lower, upper)
+				image := function.image(interval)
+				for x := lower; x <= upper; x += 1.0 / 32 {
+					y := function.f(x)
+					if !math.IsNaN(y) && !math.IsInf(y, 0) && !image.Contains(y) {
+						failures++
+					}
+				}
+			}
+		}
+	}
+	AssertEqual(failures, 0, t)
+}
+
+/* !SECTION: Elementary Function Testing */
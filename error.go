@@ -0,0 +1,105 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrInvertedBounds    = errors.New("the LowerBound endpoint cannot be higher than the UpperBound endpoint")
+	ErrMisplacedInfinity = errors.New("the LowerBound endpoint cannot be +∞ and the UpperBound endpoint cannot be -∞")
+	ErrNaNEndpoint       = errors.New("an endpoint cannot be NaN")
+	ErrInfiniteEndpoint  = errors.New("an Open or Closed endpoint cannot hold an infinite Value")
+	ErrUnboundedInterval = errors.New("the interval must be bounded")
+	ErrInvalidTolerance  = errors.New("the tolerance must be a positive finite Value")
+	ErrEmptyInterval     = errors.New("the interval cannot be Empty")
+	ErrDimensionMismatch = errors.New("the dimensions of the operands do not match")
+	ErrSingularMatrix    = errors.New("the interval matrix may hold a singular matrix")
+)
+
+/*
+	BoundsError Type to report endpoints an interval cannot be constructed from.
+	Err is one of ErrInvertedBounds, ErrMisplacedInfinity, ErrNaNEndpoint or ErrInfiniteEndpoint
+	and can be matched with errors.Is.
+*/
+type BoundsError[N Numeric] struct {
+	LowerBound Point[N]
+	UpperBound Point[N]
+	Err        error
+}
+
+func (self *BoundsError[N]) Error() string {
+	origin := Origin[N]{LowerBound: self.LowerBound, UpperBound: self.UpperBound, Recorded: true}
+	return fmt.Sprintf("interval %v: %v", origin, self.Err)
+}
+
+func (self *BoundsError[N]) Unwrap() error {
+	return self.Err
+}
+
+/*
+	EmptyDomainError Type to report the Constraint that Propagate found unsatisfiable within the domains.
+	Variable is the variable whose domain the Constraint emptied, or "" if the Constraint cannot hold for any
+	Values of the domains of its variables.
+*/
+type EmptyDomainError[N Numeric] struct {
+	Constraint Constraint[N]
+	Variable   string
+}
+
+func (self *EmptyDomainError[N]) Error() string {
+	if self.Variable == "" {
+		return fmt.Sprintf("constraint %v cannot be satisfied", self.Constraint)
+	}
+	return fmt.Sprintf("constraint %v empties the domain of %v", self.Constraint, self.Variable)
+}
+
+/* Private Boolean function that returns true if a Value is NaN. Always false for integers. */
+func isNaN[N Numeric](Value N) bool {
+	return Value != Value
+}
+
+/* Private Boolean function that returns true if a Value is ±Inf. Always false for integers. */
+func isInf[N Numeric](Value N) bool {
+	return math.IsInf(float64(Value), 0)
+}
+
+/*
+	Private function that validates the endpoints of an interval.
+
+	Parameters:
+		start Point[N]	normalized LowerBound
+		end Point[N]	normalized UpperBound
+	Return:
+		error	nil or a *BoundsError[N]
+*/
+func validateBounds[N Numeric](start, end Point[N]) error {
+	if err := boundsFault(start, end); err != nil {
+		return &BoundsError[N]{LowerBound: start, UpperBound: end, Err: err}
+	}
+	return nil
+}
+
+/* Private function that returns the sentinel error describing what is wrong with two endpoints, if anything */
+func boundsFault[N Numeric](start, end Point[N]) error {
+	for _, point := range []Point[N]{start, end} {
+		if point.IsInfinite() {
+			continue
+		}
+		if isNaN(point.Value) {
+			return ErrNaNEndpoint
+		}
+		if isInf(point.Value) {
+			return ErrInfiniteEndpoint
+		}
+	}
+	if start.Type == PositiveUnboundedPoint || end.Type == NegativeUnboundedPoint {
+		return ErrMisplacedInfinity
+	}
+	/* infinite bounds hold no Value, only finite bounds can be inverted */
+	if !start.IsInfinite() && !end.IsInfinite() && start.Value > end.Value {
+		return ErrInvertedBounds
+	}
+	return nil
+}
@@ -21,13 +21,58 @@ const (
 	UnboundedInterval                // (-∞,+∞) = {x}
 )
 
+/*
+	Interval Type to represent a set of numbers between two endpoints.
+
+	An Interval is a small comparable value: it can be compared with == and used as a map key, and it is
+	constructed and copied without any allocation. Members are computed on demand, see Values and Contains.
+*/
 type Interval[N Numeric] struct {
 	LowerBound Point[N] // start point of interval
 	UpperBound Point[N] // end point of interval
-	Values     []N      // Values of an interval. For unbounded intervals Values will be nil
 	Type       IntervalType
 }
 
+/*
+	Origin Type to report the endpoints an Empty Interval was derived from, ex: [1,2) ∩ [2,3] is derived
+	from [2,2). Only used for diagnostics, see IntersectOrigin and GapOrigin: the Empty Interval itself
+	keeps zero bounds, so that every Empty Interval is == to every other.
+*/
+type Origin[N Numeric] struct {
+	LowerBound Point[N]
+	UpperBound Point[N]
+	Recorded   bool // false when the Empty Interval was not derived from any endpoints
+}
+
+/* Public Method that returns the raw Interval Notation of the recorded endpoints, ex: [2,2) */
+func (self Origin[N]) String() string {
+	if !self.Recorded {
+		return "{}"
+	}
+	var lower, upper string
+	switch self.LowerBound.Type {
+	case OpenPoint:
+		lower = fmt.Sprintf("(%v", self.LowerBound.Value)
+	case ClosedPoint:
+		lower = fmt.Sprintf("[%v", self.LowerBound.Value)
+	case UnboundedPoint, NegativeUnboundedPoint:
+		lower = "(-∞"
+	case PositiveUnboundedPoint:
+		lower = "(+∞"
+	}
+	switch self.UpperBound.Type {
+	case OpenPoint:
+		upper = fmt.Sprintf("%v)", self.UpperBound.Value)
+	case ClosedPoint:
+		upper = fmt.Sprintf("%v]", self.UpperBound.Value)
+	case UnboundedPoint, PositiveUnboundedPoint:
+		upper = "+∞)"
+	case NegativeUnboundedPoint:
+		upper = "-∞)"
+	}
+	return lower + "," + upper
+}
+
 /*
 	Private construction function to create an interval.
 
@@ -36,17 +81,51 @@ type Interval[N Numeric] struct {
 		hi Point[N]	Higherbound Value
 	Return:
 		Interval[N] Interval Struct
+		error	*BoundsError[N] if the endpoints do not describe an interval
 */
-func createInterval[N Numeric](start, end Point[N]) Interval[N] {
-	if start.Value > end.Value {
-		panic("The LowerBound endpoint cannot be higher than the UpperBound endpoint")
+func createInterval[N Numeric](start, end Point[N]) (Interval[N], error) {
+	start, end = normalizeLowerBound(start), normalizeUpperBound(end)
+	if err := validateBounds(start, end); err != nil {
+		return Interval[N]{}, err
 	}
 	interval := Interval[N]{LowerBound: start, UpperBound: end}
 	interval.setIntervalType()
-	interval.setValues()
+	if interval.Type == EmptyInterval {
+		return GenerateEmptyInterval[N](), nil
+	}
+	return interval, nil
+}
+
+/* Private function that panics on construction errors, turning a New* constructor into a Generate* one */
+func mustInterval[N Numeric](interval Interval[N], err error) Interval[N] {
+	if err != nil {
+		panic(err)
+	}
 	return interval
 }
 
+/* Private function that resolves an UnboundedPoint used as a LowerBound to -∞ and drops the Value of infinite points */
+func normalizeLowerBound[N Numeric](p Point[N]) Point[N] {
+	switch p.Type {
+	case UnboundedPoint, NegativeUnboundedPoint:
+		return NegativeInfinity[N]()
+	case PositiveUnboundedPoint:
+		return PositiveInfinity[N]()
+	}
+	return p
+}
+
+/* Private function that resolves an UnboundedPoint used as an UpperBound to +∞ and drops the Value of infinite points */
+func normalizeUpperBound[N Numeric](p Point[N]) Point[N] {
+	switch p.Type {
+	case UnboundedPoint, PositiveUnboundedPoint:
+		return PositiveInfinity[N]()
+	case NegativeUnboundedPoint:
+		return NegativeInfinity[N]()
+	}
+	return p
+}
+
 /* Private void method to set an interval's type. */
 func (self *Interval[N]) setIntervalType() {
 	var loType, hiType PointType = self.LowerBound.Type, self.UpperBound.Type
@@ -55,7 +134,7 @@ func (self *Interval[N]) setIntervalType() {
 	if loType == OpenPoint && hiType == OpenPoint {
 		/* Empty Check: (a,a) = {} */
 		if self.LowerBound.Value == self.UpperBound.Value {
-			self.Type = DegenerateInterval
+			self.Type = EmptyInterval
 			return
 		}
 		self.Type = OpenInterval
@@ -73,7 +152,7 @@ func (self *Interval[N]) setIntervalType() {
 	} else if loType == OpenPoint && hiType == ClosedPoint {
 		/* Empty Check: (a,a] = {} */
 		if self.LowerBound.Value == self.UpperBound.Value {
-			self.Type = DegenerateInterval
+			self.Type = EmptyInterval
 			return
 		}
 		self.Type = OpenClosedInterval
@@ -82,100 +161,215 @@ func (self *Interval[N]) setIntervalType() {
 	} else if loType == ClosedPoint && hiType == OpenPoint {
 		/* Empty Check: [a,a) = {} */
 		if self.LowerBound.Value == self.UpperBound.Value {
-			self.Type = DegenerateInterval
+			self.Type = EmptyInterval
 			return
 		}
 		self.Type = ClosedOpenInterval
 
 		/* GreaterThanInterval Interval */
-	} else if loType == OpenPoint && hiType == UnboundedPoint {
+	} else if loType == OpenPoint && hiType == PositiveUnboundedPoint {
 		self.Type = GreaterThanInterval
 
 		/* AtLeastInterval Interval */
-	} else if loType == ClosedPoint && hiType == UnboundedPoint {
+	} else if loType == ClosedPoint && hiType == PositiveUnboundedPoint {
 		self.Type = AtLeastInterval
 
 		/* LessThanInterval Interval */
-	} else if loType == UnboundedPoint && hiType == OpenPoint {
+	} else if loType == NegativeUnboundedPoint && hiType == OpenPoint {
 		self.Type = LessThanInterval
 
 		/* AtMostInterval Interval */
-	} else if loType == UnboundedPoint && hiType == ClosedPoint {
+	} else if loType == NegativeUnboundedPoint && hiType == ClosedPoint {
 		self.Type = AtMostInterval
 
 		/* UnboundedInterval Interval */
-	} else if loType == UnboundedPoint && hiType == UnboundedPoint {
+	} else if loType == NegativeUnboundedPoint && hiType == PositiveUnboundedPoint {
 		self.Type = UnboundedInterval
 	}
 }
 
 /*
-	Private void method to set an interval's set of Values.
+	Private Method that returns the first and last integer Values of a bounded interval.
 
 	NOTE:
-	The initial implementaiton of this method populated endpoints with the interval's start and stop *inclusive* endpoints
-	for a user to use (i.e iteration). This was problematic when met with edge cases such as (1,2) where,
-	according to the previous logic, the endpoint slice would be []int{2,1}.
-	This would defeat the original purpose of the method.
-	Instead it will be a method to directy create a slice that holds the inclusive Values of valid bounded intervals.
+	Intervals used to materialize their Values into a slice at construction time, which made constructing
+	large intervals such as [0,1000000000] expensive. The Values are now produced lazily from these two endpoints.
+	For float types the integer Values are the integral floats of the interval, ex: 1 and 2 for [0.5,2.5].
+
+	Return:
+		first N
+		last N
+		ok bool	false if the interval is Empty, unbounded or holds no integer Value
 */
-func (self *Interval[N]) setValues() {
+func (self Interval[N]) integerRange() (first, last N, ok bool) {
 	switch self.Type {
-	case DegenerateInterval:
-		self.Values = append(self.Values, self.LowerBound.Value)
-	case OpenInterval:
-		for n := self.LowerBound.Value + 1; n < self.UpperBound.Value; n++ {
-			self.Values = append(self.Values, n)
-		}
-		break
-	case ClosedInterval:
-		for n := self.LowerBound.Value; n <= self.UpperBound.Value; n++ {
-			self.Values = append(self.Values, n)
-		}
-		break
-	case OpenClosedInterval:
-		for n := self.LowerBound.Value + 1; n <= self.UpperBound.Value; n++ {
-			self.Values = append(self.Values, n)
-		}
-		break
-	case ClosedOpenInterval:
-		for n := self.LowerBound.Value; n < self.UpperBound.Value; n++ {
-			self.Values = append(self.Values, n)
-		}
-		break
-	/* Unbounded (and Unbounded Hybrid) types cannot set a Value type since they are not finite */
-	case EmptyInterval, GreaterThanInterval, AtLeastInterval, LessThanInterval, AtMostInterval, UnboundedInterval:
-		break
+	case DegenerateInterval, OpenInterval, ClosedInterval, OpenClosedInterval, ClosedOpenInterval:
+	default:
+		return first, last, false
+	}
+	first, last = self.LowerBound.Value, self.UpperBound.Value
+	if isFloat[N]() {
+		first, last = N(math.Ceil(float64(first))), N(math.Floor(float64(last)))
+	}
+	if self.LowerBound.Type == OpenPoint && first == self.LowerBound.Value {
+		first++
+	}
+	if self.UpperBound.Type == OpenPoint && last == self.UpperBound.Value {
+		last--
 	}
+	return first, last, first <= last
 }
 
-/* SECTION: Interval Generation Functions */
+/* SECTION: Interval Construction Functions */
 
 /*
-	Public Construction Function to generate an interval.
+	Public Construction Function to create an interval from endpoints that may come from user input.
+	Half-lines are expressed with NegativeInfinity and PositiveInfinity endpoints; an UnboundedPoint is read
+	as -∞ when used as the LowerBound and as +∞ when used as the UpperBound.
 
 	Parameters:
 		LowerBound Point[N] 	Start endpoint of interval.
 		HigherBound Point[N]	End endpoint of interval.
 	Return:
 		Interval[N] Interval Struct
+		error		*BoundsError[N] wrapping ErrInvertedBounds, ErrMisplacedInfinity, ErrNaNEndpoint or ErrInfiniteEndpoint
 */
-func GenerateInterval[N Numeric](LowerBound, UpperBound Point[N]) Interval[N] {
+func NewInterval[N Numeric](LowerBound, UpperBound Point[N]) (Interval[N], error) {
 	return createInterval(LowerBound, UpperBound)
 }
 
 /*
+	Public Construction Function to create an Open Interval.
 
-	Public Function to generate an Empty Interval.
+	Parameters:
+		start N 	Start endpoint of interval.
+		end N		End endpoint of interval.
+	Return:
+		Interval[N] Interval Struct
+		error		*BoundsError[N] if an endpoint is NaN or infinite, or if start is higher than end
+*/
+func NewOpen[N Numeric](start, end N) (Interval[N], error) {
+	return createInterval(Point[N]{Value: start, Type: OpenPoint}, Point[N]{Value: end, Type: OpenPoint})
+}
+
+/*
+	Public Construction Function to create a Closed Interval.
+
+	Parameters:
+		start N 	Start endpoint of interval.
+		end N		End endpoint of interval.
+	Return:
+		Interval[N] Interval Struct
+		error		*BoundsError[N] if an endpoint is NaN or infinite, or if start is higher than end
+*/
+func NewClosed[N Numeric](start, end N) (Interval[N], error) {
+	return createInterval(Point[N]{Value: start, Type: ClosedPoint}, Point[N]{Value: end, Type: ClosedPoint})
+}
+
+/*
+	Public Construction Function to create an OpenClosed Interval.
+
+	Parameters:
+		start N 	Start endpoint of interval.
+		end N		End endpoint of interval.
+	Return:
+		Interval[N] Interval Struct
+		error		*BoundsError[N] if an endpoint is NaN or infinite, or if start is higher than end
+*/
+func NewOpenClosed[N Numeric](start, end N) (Interval[N], error) {
+	return createInterval(Point[N]{Value: start, Type: OpenPoint}, Point[N]{Value: end, Type: ClosedPoint})
+}
+
+/*
+	Public Construction Function to create a ClosedOpen Interval.
+
+	Parameters:
+		start N 	Start endpoint of interval.
+		end N		End endpoint of interval.
+	Return:
+		Interval[N] Interval Struct
+		error		*BoundsError[N] if an endpoint is NaN or infinite, or if start is higher than end
+*/
+func NewClosedOpen[N Numeric](start, end N) (Interval[N], error) {
+	return createInterval(Point[N]{Value: start, Type: ClosedPoint}, Point[N]{Value: end, Type: OpenPoint})
+}
+
+/*
+	Public Construction Function to create a GreaterThan Interval.
+
+	Parameters:
+		start N 	Start endpoint of interval.
+	Return:
+		Interval[N] Interval Struct
+		error		*BoundsError[N] if the endpoint is NaN or infinite
+*/
+func NewGreaterThan[N Numeric](start N) (Interval[N], error) {
+	return createInterval(Point[N]{Value: start, Type: OpenPoint}, PositiveInfinity[N]())
+}
+
+/*
+	Public Construction Function to create an AtLeast Interval.
+
+	Parameters:
+		start N 	Start endpoint of interval.
+	Return:
+		Interval[N] Interval Struct
+		error		*BoundsError[N] if the endpoint is NaN or infinite
+*/
+func NewAtLeast[N Numeric](start N) (Interval[N], error) {
+	return createInterval(Point[N]{Value: start, Type: ClosedPoint}, PositiveInfinity[N]())
+}
+
+/*
+	Public Construction Function to create a LessThan Interval.
+
+	Parameters:
+		end N 	End endpoint of interval.
+	Return:
+		Interval[N] Interval Struct
+		error		*BoundsError[N] if the endpoint is NaN or infinite
+*/
+func NewLessThan[N Numeric](end N) (Interval[N], error) {
+	return createInterval(NegativeInfinity[N](), Point[N]{Value: end, Type: OpenPoint})
+}
+
+/*
+	Public Construction Function to create an AtMost Interval.
+
+	Parameters:
+		end N 	End endpoint of interval.
+	Return:
+		Interval[N] Interval Struct
+		error		*BoundsError[N] if the endpoint is NaN or infinite
+*/
+func NewAtMost[N Numeric](end N) (Interval[N], error) {
+	return createInterval(NegativeInfinity[N](), Point[N]{Value: end, Type: ClosedPoint})
+}
+
+/* !SECTION: Interval Construction Functions */
+
+/* SECTION: Interval Generation Functions */
+
+/*
+	Public Construction Function to generate an interval. Panics where NewInterval returns an error.
+	Half-lines are expressed with NegativeInfinity and PositiveInfinity endpoints; an UnboundedPoint is read
+	as -∞ when used as the LowerBound and as +∞ when used as the UpperBound.
 
+	Parameters:
+		LowerBound Point[N] 	Start endpoint of interval.
+		HigherBound Point[N]	End endpoint of interval.
 	Return:
 		Interval[N] Interval Struct
+*/
+func GenerateInterval[N Numeric](LowerBound, UpperBound Point[N]) Interval[N] {
+	return mustInterval(NewInterval(LowerBound, UpperBound))
+}
 
-	FIXME: Add start and end endpoint parameters to function.
-	Empty Intervals can be created when there is an intersection between
-	two intervals that do not intersect (b_start > a_end || a_start > b_end)
-	or if the interval intersection is  at the same point but one point is Open
-	and the other Closed ex: {(2,3] ∩ (-1, 2] => (2,2]}
+/*
+	Public Function to generate an Empty Interval.
+
+	Return:
+		Interval[N] Interval Struct
 */
 func GenerateEmptyInterval[N Numeric]() Interval[N] {
 	return Interval[N]{}
@@ -191,9 +385,7 @@ func GenerateEmptyInterval[N Numeric]() Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateOpenInterval[N Numeric](start, end N) Interval[N] {
-	LowerBound := Point[N]{Value: start, Type: OpenPoint}
-	higherBound := Point[N]{Value: end, Type: OpenPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(NewOpen(start, end))
 }
 
 /*
@@ -206,9 +398,7 @@ func GenerateOpenInterval[N Numeric](start, end N) Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateClosedInterval[N Numeric](start, end N) Interval[N] {
-	LowerBound := Point[N]{Value: start, Type: ClosedPoint}
-	higherBound := Point[N]{Value: end, Type: ClosedPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(NewClosed(start, end))
 }
 
 /*
@@ -221,9 +411,7 @@ func GenerateClosedInterval[N Numeric](start, end N) Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateOpenClosedInterval[N Numeric](start, end N) Interval[N] {
-	LowerBound := Point[N]{Value: start, Type: OpenPoint}
-	higherBound := Point[N]{Value: end, Type: ClosedPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(NewOpenClosed(start, end))
 }
 
 /*
@@ -236,9 +424,7 @@ func GenerateOpenClosedInterval[N Numeric](start, end N) Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateClosedOpenInterval[N Numeric](start, end N) Interval[N] {
-	LowerBound := Point[N]{Value: start, Type: ClosedPoint}
-	higherBound := Point[N]{Value: end, Type: OpenPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(NewClosedOpen(start, end))
 }
 
 /*
@@ -250,9 +436,7 @@ func GenerateClosedOpenInterval[N Numeric](start, end N) Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateGreaterThanInterval[N Numeric](start N) Interval[N] {
-	LowerBound := Point[N]{Value: start, Type: OpenPoint}
-	higherBound := Point[N]{Value: N(math.Inf(0)), Type: UnboundedPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(NewGreaterThan(start))
 }
 
 /*
@@ -264,9 +448,7 @@ func GenerateGreaterThanInterval[N Numeric](start N) Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateAtLeastInterval[N Numeric](start N) Interval[N] {
-	LowerBound := Point[N]{Value: start, Type: ClosedPoint}
-	higherBound := Point[N]{Value: N(math.Inf(0)), Type: UnboundedPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(NewAtLeast(start))
 }
 
 /*
@@ -278,9 +460,7 @@ func GenerateAtLeastInterval[N Numeric](start N) Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateLessThanInterval[N Numeric](end N) Interval[N] {
-	LowerBound := Point[N]{Value: N(math.Inf(-1)), Type: UnboundedPoint}
-	higherBound := Point[N]{Value: end, Type: OpenPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(NewLessThan(end))
 }
 
 /*
@@ -292,9 +472,7 @@ func GenerateLessThanInterval[N Numeric](end N) Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateAtMostInterval[N Numeric](end N) Interval[N] {
-	LowerBound := Point[N]{Value: N(math.Inf(-1)), Type: UnboundedPoint}
-	higherBound := Point[N]{Value: end, Type: ClosedPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(NewAtMost(end))
 }
 
 /*
@@ -306,9 +484,7 @@ func GenerateAtMostInterval[N Numeric](end N) Interval[N] {
 		Interval[N] Interval Struct
 */
 func GenerateUnboundedInterval[N Numeric]() Interval[N] {
-	LowerBound := Point[N]{Value: N(math.Inf(-1)), Type: UnboundedPoint}
-	higherBound := Point[N]{Value: N(math.Inf(0)), Type: UnboundedPoint}
-	return createInterval(LowerBound, higherBound)
+	return mustInterval(createInterval(NegativeInfinity[N](), PositiveInfinity[N]()))
 }
 
 /* !SECTION: Interval Generation Functions */
@@ -321,55 +497,40 @@ func GenerateUnboundedInterval[N Numeric]() Interval[N] {
 	Return:
 		bool
 */
-func (self *Interval[N]) Contains(Value N) bool {
-	switch self.Type {
-	case EmptyInterval:
+func (self Interval[N]) Contains(Value N) bool {
+	if self.Type == EmptyInterval {
 		return false
-	case OpenInterval:
-		return self.LowerBound.Value < Value && self.UpperBound.Value > Value
-	case ClosedInterval:
-		return self.LowerBound.Value <= Value && self.UpperBound.Value >= Value
-	case OpenClosedInterval:
-		return self.LowerBound.Value < Value && self.UpperBound.Value >= Value
-	case ClosedOpenInterval:
-		return self.LowerBound.Value <= Value && self.UpperBound.Value > Value
-	case GreaterThanInterval:
-		return self.LowerBound.Value < Value
-	case AtLeastInterval:
-		return self.LowerBound.Value <= Value
-	case LessThanInterval:
-		return self.UpperBound.Value > Value
-	case AtMostInterval:
-		return self.UpperBound.Value >= Value
-	case UnboundedInterval:
-		return true
 	}
-	return false
+	return boundsContain(self.LowerBound, self.UpperBound, Value)
 }
 
 /*
-	Public Integer Method that returns the amount of numbers in an interval. Unbounded intervals
-	returns math.inf
+	Public Integer Method that returns the amount of numbers in an interval. Unbounded intervals, and
+	intervals holding more numbers than an int can count, return math.MaxInt: use Cardinality to tell
+	them apart.
 
 	Return:
 		int
 */
-func (self *Interval[N]) Count() int {
+func (self Interval[N]) Count() int {
 	switch self.Type {
 	case EmptyInterval:
 		return 0
 	case DegenerateInterval:
 		return 1
 	case OpenInterval, ClosedInterval, OpenClosedInterval, ClosedOpenInterval:
-		return len(self.Values)
+		if size := self.size(); size < math.MaxInt {
+			return int(size)
+		}
+		return math.MaxInt
 	case GreaterThanInterval, AtLeastInterval, LessThanInterval, AtMostInterval, UnboundedInterval:
-		return int(math.Inf(0))
+		return math.MaxInt
 	}
 	return 0
 }
 
 /* Public Method that returns the Interval Notation representation of the interval  */
-func (self *Interval[N]) String() string {
+func (self Interval[N]) String() string {
 	var notation string = "{}"
 	switch self.Type {
 	case DegenerateInterval:
@@ -407,7 +568,7 @@ func (self *Interval[N]) String() string {
 }
 
 /* Public Method that returns the set notation of the interval. */
-func (self *Interval[N]) SetNotation() string {
+func (self Interval[N]) SetNotation() string {
 	var notation string = "{}"
 	switch self.Type {
 	case DegenerateInterval:
@@ -455,15 +616,166 @@ func (self *Interval[N]) SetNotation() string {
 
 */
 func Intersect[N Numeric](a, b Interval[N]) Interval[N] {
+	c, _ := IntersectOrigin(a, b)
+	return c
+}
+
+/*
+	Public Interval Function that returns the intersect (∩) between two intervals, with the endpoints it was
+	derived from when it is Empty, ex: [1,2) ∩ [2,3] is Empty and derived from [2,2).
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c Interval[N]	a ∩ b
+		origin Origin[N]	recorded only if a and b are not Empty and c is Empty
+*/
+func IntersectOrigin[N Numeric](a, b Interval[N]) (Interval[N], Origin[N]) {
 	/* an intersection involving an Empty Interval always results in an Empty Interval */
 	if a.Type == EmptyInterval || b.Type == EmptyInterval {
-		return GenerateEmptyInterval[N]()
+		return GenerateEmptyInterval[N](), Origin[N]{}
+	}
+	start, end := startPointIntersect(a.LowerBound, b.LowerBound), endPointIntersect(a.UpperBound, b.UpperBound)
+	/* the intervals do not intersect, or only share an endpoint that one of them excludes: [1,2) ∩ [2,3] */
+	if boundsEmpty(start, end) {
+		return GenerateEmptyInterval[N](), Origin[N]{LowerBound: start, UpperBound: end, Recorded: true}
+	}
+	return GenerateInterval(start, end), Origin[N]{}
+}
+
+/*
+	Public Interval Function that returns the hull of two intervals: the smallest interval enclosing both,
+	ex: the hull of [1,3) and (5,8] is [1,8]. The hull of an interval and an Empty Interval is the interval.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c Interval[N]
+*/
+func Hull[N Numeric](a, b Interval[N]) Interval[N] {
+	if a.Type == EmptyInterval {
+		return b
+	}
+	if b.Type == EmptyInterval {
+		return a
+	}
+	return GenerateInterval(startPointHull(a.LowerBound, b.LowerBound), endPointHull(a.UpperBound, b.UpperBound))
+}
+
+/*
+	Public Interval Function that returns the smallest interval enclosing every given interval.
+
+	Parameters:
+		intervals ...Interval[N]
+	Return:
+		c Interval[N]	an Empty Interval if no interval, or only Empty Intervals, are given
+*/
+func Span[N Numeric](intervals ...Interval[N]) Interval[N] {
+	span := GenerateEmptyInterval[N]()
+	for _, interval := range intervals {
+		span = Hull(span, interval)
 	}
-	/* adopted from: https://stackoverflow.com/a/325964/6427171. Checks if the two intervals intersect. */
-	if Max(a.LowerBound.Value, b.LowerBound.Value) > Min(a.UpperBound.Value, b.UpperBound.Value) {
-		return GenerateEmptyInterval[N]()
-	} else { /* the intervals intersect */
-		start, end := startPointIntersect(a.LowerBound, b.LowerBound), endPointIntersect(a.UpperBound, b.UpperBound)
-		return GenerateInterval(start, end)
+	return span
+}
+
+/*
+	Public Interval Function that returns the interval strictly between two disjoint intervals,
+	ex: the gap between [1,3) and (5,8] is [3,5]. The order of a and b does not matter.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c Interval[N]	an Empty Interval if a and b overlap or are adjacent, or if either is Empty
+*/
+func Gap[N Numeric](a, b Interval[N]) Interval[N] {
+	c, _ := GapOrigin(a, b)
+	return c
+}
+
+/*
+	Public Interval Function that returns the interval strictly between two disjoint intervals, with the
+	endpoints it was derived from when it is Empty, ex: the gap between [1,2) and [2,3] is derived from [2,2).
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c Interval[N]
+		origin Origin[N]	recorded only if a and b are adjacent
+*/
+func GapOrigin[N Numeric](a, b Interval[N]) (Interval[N], Origin[N]) {
+	if a.Type == EmptyInterval || b.Type == EmptyInterval || a.Overlaps(b) {
+		return GenerateEmptyInterval[N](), Origin[N]{}
+	}
+	if CompareBounds(a.LowerBound.AsLower(), b.LowerBound.AsLower()) > 0 {
+		a, b = b, a
+	}
+	start, end := complementPoint(a.UpperBound), complementPoint(b.LowerBound)
+	/* adjacent intervals leave no gap: [1,2) and [2,3] */
+	if boundsEmpty(start, end) {
+		return GenerateEmptyInterval[N](), Origin[N]{LowerBound: start, UpperBound: end, Recorded: true}
+	}
+	return GenerateInterval(start, end), Origin[N]{}
+}
+
+/*
+	Public IntervalSet Function that returns the union (∪) of two intervals.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c IntervalSet[N]	a ∪ b
+*/
+func Union[N Numeric](a, b Interval[N]) IntervalSet[N] {
+	return NewIntervalSet(a, b)
+}
+
+/*
+	Public IntervalSet Function that returns the difference (∖) between two intervals.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c IntervalSet[N]	a ∖ b
+*/
+func Difference[N Numeric](a, b Interval[N]) IntervalSet[N] {
+	set := NewIntervalSet(a)
+	set.Remove(b)
+	return set
+}
+
+/*
+	Public IntervalSet Function that returns the symmetric difference (△) between two intervals,
+	the Values that are in exactly one of them.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		c IntervalSet[N]	a △ b = (a ∖ b) ∪ (b ∖ a)
+*/
+func SymmetricDifference[N Numeric](a, b Interval[N]) IntervalSet[N] {
+	set := Difference(a, b)
+	for _, interval := range Difference(b, a).intervals {
+		set.Add(interval)
 	}
+	return set
+}
+
+/*
+	Public IntervalSet Function that returns the complement (∁) of an interval on the number line.
+	Every finite boundary flips between Open and Closed, ex: ∁[2,5) = (-∞,2) ∪ [5,+∞).
+
+	Parameters:
+		a Interval[N]
+	Return:
+		c IntervalSet[N]	(-∞,+∞) ∖ a
+*/
+func Complement[N Numeric](a Interval[N]) IntervalSet[N] {
+	return Difference(GenerateUnboundedInterval[N](), a)
 }
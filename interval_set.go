@@ -0,0 +1,158 @@
+package interval
+
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+/*
+	IntervalSet Type to represent a union of intervals.
+
+	The intervals are kept normalized: sorted by their LowerBound, pairwise disjoint and coalesced, so that
+	two intervals that overlap or touch (ex: [1,3) and [3,5]) are stored as a single interval ([1,5]).
+	The zero value is an empty set ready to use. See NewDiscreteIntervalSet for sets over an integer domain.
+*/
+type IntervalSet[N Numeric] struct {
+	intervals []Interval[N]
+	discrete  bool // intervals are kept Canonical and coalesced when adjacent over the integers
+}
+
+/*
+	Public Construction Function to generate an interval set.
+
+	Parameters:
+		intervals ...Interval[N]	intervals to add to the set.
+	Return:
+		IntervalSet[N] IntervalSet Struct
+*/
+func NewIntervalSet[N Numeric](intervals ...Interval[N]) IntervalSet[N] {
+	set := IntervalSet[N]{}
+	for _, interval := range intervals {
+		set.Add(interval)
+	}
+	return set
+}
+
+/* Private Boolean Function that returns true if an interval holds no Value, regardless of its Type. */
+func isEmptyInterval[N Numeric](interval Interval[N]) bool {
+	return interval.Type == EmptyInterval || boundsEmpty(interval.LowerBound, interval.UpperBound)
+}
+
+/*
+	Public void method that adds (∪) an interval to the set.
+
+	Parameters:
+		interval Interval[N]
+*/
+func (self *IntervalSet[N]) Add(interval Interval[N]) {
+	if self.discrete {
+		interval = interval.Canonical()
+	}
+	if isEmptyInterval(interval) {
+		return
+	}
+	intervals := append(slices.Clone(self.intervals), interval)
+	slices.SortFunc(intervals, func(a, b Interval[N]) bool {
+		return CompareBounds(a.LowerBound.AsLower(), b.LowerBound.AsLower()) < 0
+	})
+
+	/* coalesce overlapping and touching neighbours */
+	merged := intervals[:1]
+	for _, next := range intervals[1:] {
+		last := &merged[len(merged)-1]
+		if !self.touch(last.UpperBound, next.LowerBound) {
+			merged = append(merged, next)
+			continue
+		}
+		if CompareBounds(next.UpperBound.AsUpper(), last.UpperBound.AsUpper()) > 0 {
+			*last = GenerateInterval(last.LowerBound, next.UpperBound)
+		}
+	}
+	self.intervals = merged
+}
+
+/* Private Boolean Method that returns true if two neighbouring intervals of the set should be coalesced */
+func (self *IntervalSet[N]) touch(u, l Point[N]) bool {
+	return boundsTouch(u, l) || self.discrete && discreteTouch(u, l)
+}
+
+/*
+	Public void method that removes (∖) an interval from the set.
+
+	Parameters:
+		interval Interval[N]
+*/
+func (self *IntervalSet[N]) Remove(interval Interval[N]) {
+	if isEmptyInterval(interval) {
+		return
+	}
+	var remaining []Interval[N]
+	for _, current := range self.intervals {
+		/* part of current left of the removed interval: [current.LowerBound, interval.LowerBound) */
+		if !interval.LowerBound.IsInfinite() {
+			end := complementPoint(interval.LowerBound)
+			end = endPointIntersect(current.UpperBound, end)
+			if !boundsEmpty(current.LowerBound, end) {
+				remaining = append(remaining, GenerateInterval(current.LowerBound, end))
+			}
+		}
+		/* part of current right of the removed interval: (interval.UpperBound, current.UpperBound] */
+		if !interval.UpperBound.IsInfinite() {
+			start := complementPoint(interval.UpperBound)
+			start = startPointIntersect(current.LowerBound, start)
+			if !boundsEmpty(start, current.UpperBound) {
+				remaining = append(remaining, GenerateInterval(start, current.UpperBound))
+			}
+		}
+	}
+	if self.discrete {
+		canonical := remaining[:0]
+		for _, interval := range remaining {
+			if interval = interval.Canonical(); interval.Type != EmptyInterval {
+				canonical = append(canonical, interval)
+			}
+		}
+		remaining = canonical
+	}
+	self.intervals = remaining
+}
+
+/*
+	Public Boolean Method that returns true if a Value is within one of the set's intervals. False otherwise.
+
+	Parameters:
+		Value N
+	Return:
+		bool
+*/
+func (self IntervalSet[N]) Contains(Value N) bool {
+	for _, interval := range self.intervals {
+		if boundsContain(interval.LowerBound, interval.UpperBound, Value) {
+			return true
+		}
+	}
+	return false
+}
+
+/* Public Method that returns a copy of the set's disjoint intervals, sorted by LowerBound. */
+func (self IntervalSet[N]) Intervals() []Interval[N] {
+	return slices.Clone(self.intervals)
+}
+
+/* Public Boolean Method that returns true if the set holds no interval. False otherwise. */
+func (self IntervalSet[N]) IsEmpty() bool {
+	return len(self.intervals) == 0
+}
+
+/* Public Method that returns the Interval Notation representation of the set, ex: [1,3) ∪ (5,9] */
+func (self IntervalSet[N]) String() string {
+	if self.IsEmpty() {
+		return "{}"
+	}
+	notations := make([]string, len(self.intervals))
+	for i := range self.intervals {
+		notations[i] = self.intervals[i].String()
+	}
+	return strings.Join(notations, " ∪ ")
+}
@@ -0,0 +1,100 @@
+package interval
+
+import "testing"
+
+/* SECTION: IntervalSet Testing */
+
+func TestEmptyIntervalSet(t *testing.T) {
+	set := NewIntervalSet[int]()
+	AssertTrue(set.IsEmpty(), t)
+	AssertEqual(len(set.Intervals()), 0, t)
+	AssertFalse(set.Contains(0), t)
+	AssertEqual(set.String(), "{}", t)
+
+	set.Add(GenerateEmptyInterval[int]())
+	AssertTrue(set.IsEmpty(), t)
+}
+
+func TestIntervalSetAddDisjoint(t *testing.T) {
+	set := NewIntervalSet(GenerateOpenClosedInterval(5, 9), GenerateClosedOpenInterval(1, 3))
+	AssertFalse(set.IsEmpty(), t)
+	AssertEqual(len(set.Intervals()), 2, t)
+	AssertEqual(set.String(), "[1,3) ∪ (5,9]", t)
+	AssertTrue(set.Contains(1), t)
+	AssertFalse(set.Contains(3), t)
+	AssertFalse(set.Contains(5), t)
+	AssertTrue(set.Contains(9), t)
+}
+
+func TestIntervalSetAddOverlapping(t *testing.T) {
+	set := NewIntervalSet(GenerateClosedInterval(1, 4), GenerateOpenInterval(3, 6))
+	AssertEqual(len(set.Intervals()), 1, t)
+	AssertEqual(set.String(), "[1,6)", t)
+}
+
+func TestIntervalSetAddTouching(t *testing.T) {
+	/* [1,3) ∪ [3,5] share no point but leave no gap */
+	set := NewIntervalSet(GenerateClosedOpenInterval(1, 3), GenerateClosedInterval(3, 5))
+	AssertEqual(set.String(), "[1,5]", t)
+
+	/* (1,3) ∪ (3,5) leave 3 out */
+	set = NewIntervalSet(GenerateOpenInterval(1, 3), GenerateOpenInterval(3, 5))
+	AssertEqual(set.String(), "(1,3) ∪ (3,5)", t)
+	AssertFalse(set.Contains(3), t)
+
+	/* filling the gap coalesces everything */
+	set.Add(GenerateClosedInterval(3, 3))
+	AssertEqual(set.String(), "(1,5)", t)
+	AssertTrue(set.Contains(3), t)
+}
+
+func TestIntervalSetAddContained(t *testing.T) {
+	set := NewIntervalSet(GenerateClosedInterval(1, 9), GenerateOpenInterval(2, 4))
+	AssertEqual(set.String(), "[1,9]", t)
+}
+
+func TestIntervalSetAddUnbounded(t *testing.T) {
+	set := NewIntervalSet(GenerateClosedInterval(1.0, 2.0), GenerateGreaterThanInterval(5.0))
+	AssertEqual(set.String(), "[1,2] ∪ (5,+∞)", t)
+	AssertTrue(set.Contains(1000), t)
+	set.Add(GenerateAtMostInterval(1.5))
+	AssertEqual(set.String(), "(-∞,2] ∪ (5,+∞)", t)
+	set.Add(GenerateClosedInterval(2.0, 5.0))
+	AssertEqual(set.String(), "(-∞,+∞)", t)
+}
+
+func TestIntervalSetRemove(t *testing.T) {
+	set := NewIntervalSet(GenerateClosedInterval(1, 9))
+	set.Remove(GenerateClosedOpenInterval(3, 5))
+	AssertEqual(set.String(), "[1,3) ∪ [5,9]", t)
+	AssertFalse(set.Contains(3), t)
+	AssertTrue(set.Contains(5), t)
+
+	set.Remove(GenerateOpenInterval(0, 2))
+	AssertEqual(set.String(), "[2,3) ∪ [5,9]", t)
+
+	set.Remove(GenerateClosedInterval(20, 30))
+	AssertEqual(set.String(), "[2,3) ∪ [5,9]", t)
+
+	set.Remove(GenerateClosedInterval(0, 9))
+	AssertTrue(set.IsEmpty(), t)
+}
+
+func TestIntervalSetRemoveUnbounded(t *testing.T) {
+	set := NewIntervalSet(GenerateUnboundedInterval[float64]())
+	set.Remove(GenerateClosedOpenInterval(2.0, 5.0))
+	AssertEqual(set.String(), "(-∞,2) ∪ [5,+∞)", t)
+	set.Remove(GenerateAtLeastInterval(7.0))
+	AssertEqual(set.String(), "(-∞,2) ∪ [5,7)", t)
+	set.Remove(GenerateLessThanInterval(0.0))
+	AssertEqual(set.String(), "[0,2) ∪ [5,7)", t)
+}
+
+func TestIntervalSetIntervalsIsCopy(t *testing.T) {
+	set := NewIntervalSet(GenerateClosedInterval(1, 3))
+	intervals := set.Intervals()
+	intervals[0] = GenerateClosedInterval(7, 8)
+	AssertEqual(set.String(), "[1,3]", t)
+}
+
+/* !SECTION: IntervalSet Testing */
@@ -1,15 +1,84 @@
 package interval
 
 import (
+	"errors"
 	"math"
 	"testing"
 )
 
+/* SECTION: Interval Construction Testing */
+
+func TestNewInterval(t *testing.T) {
+	interval, err := NewClosedOpen(1, 5)
+	AssertTrue(err == nil, t)
+	AssertEqual(interval.String(), "[1,5)", t)
+
+	interval, err = NewOpen(2, 2)
+	AssertTrue(err == nil, t)
+	AssertEqual(interval.Type, EmptyInterval, t)
+
+	interval, err = NewInterval(NegativeInfinity[int](), Point[int]{Value: 3, Type: ClosedPoint})
+	AssertTrue(err == nil, t)
+	AssertEqual(interval.String(), "(-∞,3]", t)
+}
+
+func TestNewIntervalErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		create   func() (Interval[float64], error)
+		expected error
+	}{
+		{"inverted", func() (Interval[float64], error) { return NewClosed(5.0, 1.0) }, ErrInvertedBounds},
+		{"inverted open", func() (Interval[float64], error) { return NewOpen(5.0, 1.0) }, ErrInvertedBounds},
+		{"NaN start", func() (Interval[float64], error) { return NewOpenClosed(math.NaN(), 1.0) }, ErrNaNEndpoint},
+		{"NaN end", func() (Interval[float64], error) { return NewAtMost(math.NaN()) }, ErrNaNEndpoint},
+		{"infinite start", func() (Interval[float64], error) { return NewClosedOpen(math.Inf(-1), 1.0) }, ErrInfiniteEndpoint},
+		{"infinite end", func() (Interval[float64], error) { return NewGreaterThan(math.Inf(1)) }, ErrInfiniteEndpoint},
+		{"infinite Value in a Closed Point", func() (Interval[float64], error) {
+			return NewInterval(Point[float64]{Value: math.Inf(-1), Type: ClosedPoint}, PositiveInfinity[float64]())
+		}, ErrInfiniteEndpoint},
+		{"+∞ LowerBound", func() (Interval[float64], error) {
+			return NewInterval(PositiveInfinity[float64](), PositiveInfinity[float64]())
+		}, ErrMisplacedInfinity},
+		{"-∞ UpperBound", func() (Interval[float64], error) {
+			return NewInterval(Point[float64]{Value: 1, Type: OpenPoint}, NegativeInfinity[float64]())
+		}, ErrMisplacedInfinity},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			interval, err := test.create()
+			AssertTrue(errors.Is(err, test.expected), t)
+			var boundsErr *BoundsError[float64]
+			AssertTrue(errors.As(err, &boundsErr), t)
+			AssertEqual(interval.Type, EmptyInterval, t)
+		})
+	}
+}
+
+func TestBoundsErrorMessage(t *testing.T) {
+	_, err := NewClosedOpen(5, 1)
+	AssertEqual(err.Error(), "interval [5,1): the LowerBound endpoint cannot be higher than the UpperBound endpoint", t)
+	var boundsErr *BoundsError[int]
+	AssertTrue(errors.As(err, &boundsErr), t)
+	AssertEqual(boundsErr.LowerBound, Point[int]{Value: 5, Type: ClosedPoint}, t)
+	AssertEqual(boundsErr.UpperBound, Point[int]{Value: 1, Type: OpenPoint}, t)
+}
+
+func TestGenerateIntervalPanics(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		AssertTrue(errors.Is(err, ErrInvertedBounds), t)
+	}()
+	GenerateClosedInterval(5, 1)
+}
+
+/* !SECTION: Interval Construction Testing */
+
 /* SECTION: Interval Generation Testing  */
 
 func TestGenerateEmptyInterval(t *testing.T) {
 	interval := GenerateEmptyInterval[int]()
-	AssertEqual(len(interval.Values), 0, t)
+	AssertEqual(len(collect(interval.Values())), 0, t)
 	AssertEqual(interval.String(), "{}", t)
 	AssertEqual(interval.SetNotation(), "{}", t)
 	AssertEqual(interval.Type, EmptyInterval, t)
@@ -17,13 +86,14 @@ func TestGenerateEmptyInterval(t *testing.T) {
 
 func TestGenerateOpenInterval(t *testing.T) {
 	interval := GenerateOpenInterval(0, 9)
-	AssertEqual(len(interval.Values), 8, t)
+	values := collect(interval.Values())
+	AssertEqual(len(values), 8, t)
 	AssertEqual(interval.LowerBound.Value, 0, t)
 	AssertEqual(interval.LowerBound.Type, OpenPoint, t)
-	AssertEqual(interval.Values[0], 1, t)
+	AssertEqual(values[0], 1, t)
 	AssertEqual(interval.UpperBound.Value, 9, t)
 	AssertEqual(interval.UpperBound.Type, OpenPoint, t)
-	AssertEqual(interval.Values[len(interval.Values)-1], 8, t)
+	AssertEqual(values[len(values)-1], 8, t)
 	AssertEqual(interval.Count(), 8, t)
 	AssertFalse(interval.Contains(0), t)
 	AssertFalse(interval.Contains(9), t)
@@ -35,13 +105,14 @@ func TestGenerateOpenInterval(t *testing.T) {
 
 func TestGenerateClosedInterval(t *testing.T) {
 	interval := GenerateClosedInterval(0, 9)
-	AssertEqual(len(interval.Values), 10, t)
+	values := collect(interval.Values())
+	AssertEqual(len(values), 10, t)
 	AssertEqual(interval.LowerBound.Value, 0, t)
 	AssertEqual(interval.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(interval.Values[0], 0, t)
+	AssertEqual(values[0], 0, t)
 	AssertEqual(interval.UpperBound.Value, 9, t)
 	AssertEqual(interval.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(interval.Values[len(interval.Values)-1], 9, t)
+	AssertEqual(values[len(values)-1], 9, t)
 	AssertEqual(interval.Count(), 10, t)
 	AssertTrue(interval.Contains(0), t)
 	AssertTrue(interval.Contains(9), t)
@@ -53,13 +124,14 @@ func TestGenerateClosedInterval(t *testing.T) {
 
 func TestGenerateOpenClosedInterval(t *testing.T) {
 	interval := GenerateOpenClosedInterval(0, 9)
-	AssertEqual(len(interval.Values), 9, t)
+	values := collect(interval.Values())
+	AssertEqual(len(values), 9, t)
 	AssertEqual(interval.LowerBound.Value, 0, t)
 	AssertEqual(interval.LowerBound.Type, OpenPoint, t)
-	AssertEqual(interval.Values[0], 1, t)
+	AssertEqual(values[0], 1, t)
 	AssertEqual(interval.UpperBound.Value, 9, t)
 	AssertEqual(interval.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(interval.Values[len(interval.Values)-1], 9, t)
+	AssertEqual(values[len(values)-1], 9, t)
 	AssertEqual(interval.Count(), 9, t)
 	AssertFalse(interval.Contains(0), t)
 	AssertTrue(interval.Contains(9), t)
@@ -71,13 +143,14 @@ func TestGenerateOpenClosedInterval(t *testing.T) {
 
 func TestGenerateClosedOpenInterval(t *testing.T) {
 	interval := GenerateClosedOpenInterval(0, 9)
-	AssertEqual(len(interval.Values), 9, t)
+	values := collect(interval.Values())
+	AssertEqual(len(values), 9, t)
 	AssertEqual(interval.LowerBound.Value, 0, t)
 	AssertEqual(interval.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(interval.Values[0], 0, t)
+	AssertEqual(values[0], 0, t)
 	AssertEqual(interval.UpperBound.Value, 9, t)
 	AssertEqual(interval.UpperBound.Type, OpenPoint, t)
-	AssertEqual(interval.Values[len(interval.Values)-1], 8, t)
+	AssertEqual(values[len(values)-1], 8, t)
 	AssertEqual(interval.Count(), 9, t)
 	AssertTrue(interval.Contains(0), t)
 	AssertFalse(interval.Contains(9), t)
@@ -89,11 +162,11 @@ func TestGenerateClosedOpenInterval(t *testing.T) {
 
 func TestGenerateGreaterThanInterval(t *testing.T) {
 	interval := GenerateGreaterThanInterval(9)
-	AssertEqual(len(interval.Values), 0, t)
+	AssertEqual(len(collect(interval.Values())), 0, t)
 	AssertEqual(interval.LowerBound.Value, 9, t)
 	AssertEqual(interval.LowerBound.Type, OpenPoint, t)
-	AssertEqual(interval.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(interval.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(interval.UpperBound.Value, 0, t)
+	AssertEqual(interval.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertFalse(interval.Contains(9), t)
 	AssertTrue(interval.Contains(10), t)
 	AssertTrue(interval.Contains(500), t)
@@ -104,11 +177,11 @@ func TestGenerateGreaterThanInterval(t *testing.T) {
 
 func TestGenerateAtLeastInterval(t *testing.T) {
 	interval := GenerateAtLeastInterval(9)
-	AssertEqual(len(interval.Values), 0, t)
+	AssertEqual(len(collect(interval.Values())), 0, t)
 	AssertEqual(interval.LowerBound.Value, 9, t)
 	AssertEqual(interval.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(interval.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(interval.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(interval.UpperBound.Value, 0, t)
+	AssertEqual(interval.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertTrue(interval.Contains(9), t)
 	AssertTrue(interval.Contains(10), t)
 	AssertTrue(interval.Contains(500), t)
@@ -119,9 +192,9 @@ func TestGenerateAtLeastInterval(t *testing.T) {
 
 func TestGenerateLessThanInterval(t *testing.T) {
 	interval := GenerateLessThanInterval(9)
-	AssertEqual(len(interval.Values), 0, t)
-	AssertEqual(interval.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(interval.LowerBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(interval.Values())), 0, t)
+	AssertEqual(interval.LowerBound.Value, 0, t)
+	AssertEqual(interval.LowerBound.Type, NegativeUnboundedPoint, t)
 	AssertEqual(interval.UpperBound.Value, 9, t)
 	AssertEqual(interval.UpperBound.Type, OpenPoint, t)
 	AssertFalse(interval.Contains(9), t)
@@ -134,9 +207,9 @@ func TestGenerateLessThanInterval(t *testing.T) {
 
 func TestGenerateAtMostInterval(t *testing.T) {
 	interval := GenerateAtMostInterval(9)
-	AssertEqual(len(interval.Values), 0, t)
-	AssertEqual(interval.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(interval.LowerBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(interval.Values())), 0, t)
+	AssertEqual(interval.LowerBound.Value, 0, t)
+	AssertEqual(interval.LowerBound.Type, NegativeUnboundedPoint, t)
 	AssertEqual(interval.UpperBound.Value, 9, t)
 	AssertEqual(interval.UpperBound.Type, ClosedPoint, t)
 	AssertTrue(interval.Contains(9), t)
@@ -149,11 +222,11 @@ func TestGenerateAtMostInterval(t *testing.T) {
 
 func TestGenerateUnboundInterval(t *testing.T) {
 	interval := GenerateUnboundedInterval[int]()
-	AssertEqual(len(interval.Values), 0, t)
-	AssertEqual(interval.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(interval.LowerBound.Type, UnboundedPoint, t)
-	AssertEqual(interval.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(interval.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(interval.Values())), 0, t)
+	AssertEqual(interval.LowerBound.Value, 0, t)
+	AssertEqual(interval.LowerBound.Type, NegativeUnboundedPoint, t)
+	AssertEqual(interval.UpperBound.Value, 0, t)
+	AssertEqual(interval.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertTrue(interval.Contains(9), t)
 	AssertTrue(interval.Contains(5), t)
 	AssertTrue(interval.Contains(-500), t)
@@ -162,6 +235,72 @@ func TestGenerateUnboundInterval(t *testing.T) {
 	AssertEqual(interval.Type, UnboundedInterval, t)
 }
 
+/* (a,a), [a,a) and (a,a] hold no Value */
+func TestGenerateEmptyBoundsInterval(t *testing.T) {
+	for _, interval := range []Interval[int]{GenerateOpenInterval(2, 2), GenerateClosedOpenInterval(2, 2), GenerateOpenClosedInterval(2, 2)} {
+		AssertEqual(len(collect(interval.Values())), 0, t)
+		AssertEqual(interval.Count(), 0, t)
+		AssertFalse(interval.Contains(2), t)
+		AssertEqual(interval.String(), "{}", t)
+		AssertEqual(interval.SetNotation(), "{}", t)
+		AssertEqual(interval.Type, EmptyInterval, t)
+		AssertEqual(interval.LowerBound, Point[int]{}, t)
+		AssertEqual(interval.UpperBound, Point[int]{}, t)
+		AssertEqual(interval, GenerateEmptyInterval[int](), t)
+	}
+}
+
+func TestIntersectOrigin(t *testing.T) {
+	AssertEqual(Origin[int]{}.String(), "{}", t)
+
+	c, origin := IntersectOrigin(GenerateClosedOpenInterval(1, 2), GenerateClosedInterval(2, 3))
+	AssertEqual(c, GenerateEmptyInterval[int](), t)
+	AssertEqual(origin.String(), "[2,2)", t)
+
+	c, origin = IntersectOrigin(GenerateAtLeastInterval(2), GenerateAtMostInterval(1))
+	AssertEqual(c.Type, EmptyInterval, t)
+	AssertEqual(origin.String(), "[2,1]", t)
+
+	_, origin = IntersectOrigin(GenerateEmptyInterval[int](), GenerateAtMostInterval(1))
+	AssertFalse(origin.Recorded, t)
+
+	c, origin = IntersectOrigin(GenerateClosedInterval(1, 3), GenerateClosedInterval(2, 4))
+	AssertEqual(c.String(), "[2,3]", t)
+	AssertFalse(origin.Recorded, t)
+}
+
+func TestGenerateInfiniteBoundsInterval(t *testing.T) {
+	interval := GenerateInterval(Point[int]{Value: 3, Type: ClosedPoint}, PositiveInfinity[int]())
+	AssertEqual(interval.String(), "[3,+∞)", t)
+	AssertEqual(interval.Type, AtLeastInterval, t)
+	interval = GenerateInterval(NegativeInfinity[int](), Point[int]{Value: 3, Type: OpenPoint})
+	AssertEqual(interval.String(), "(-∞,3)", t)
+	AssertEqual(interval.Type, LessThanInterval, t)
+	bytes := GenerateInterval(NegativeInfinity[uint8](), PositiveInfinity[uint8]())
+	AssertEqual(bytes.Type, UnboundedInterval, t)
+	AssertTrue(bytes.Contains(255), t)
+
+	/* the Value of an UnboundedPoint is dropped and its sign comes from the side it bounds */
+	interval = GenerateInterval(Point[int]{Value: 42, Type: UnboundedPoint}, Point[int]{Value: -42, Type: UnboundedPoint})
+	AssertEqual(interval.LowerBound, NegativeInfinity[int](), t)
+	AssertEqual(interval.UpperBound, PositiveInfinity[int](), t)
+	AssertEqual(interval.Type, UnboundedInterval, t)
+
+	bytes = GenerateGreaterThanInterval[uint8](200)
+	AssertTrue(bytes.Contains(255), t)
+	AssertFalse(bytes.Contains(200), t)
+	AssertTrue(bytes.UpperBound.IsInfinite(), t)
+	AssertFalse(bytes.LowerBound.IsInfinite(), t)
+}
+
+func TestGenerateMisplacedInfinityInterval(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		AssertTrue(errors.Is(err, ErrMisplacedInfinity), t)
+	}()
+	GenerateInterval(PositiveInfinity[int](), PositiveInfinity[int]())
+}
+
 /* !SECTION: Interval Generation Testing  */
 
 /* SECTION: Intersection Testing */
@@ -173,10 +312,11 @@ func TestOOIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateOpenInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 1, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 1, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[0], 3, t)
+	AssertEqual(values[0], 3, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
 	AssertTrue(c.Contains(3), t)
@@ -192,13 +332,14 @@ func TestOCIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateClosedInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(2), t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
@@ -213,10 +354,11 @@ func TestOOCIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateOpenClosedInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 1, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 1, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[0], 3, t)
+	AssertEqual(values[0], 3, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
 	AssertTrue(c.Contains(3), t)
@@ -232,13 +374,14 @@ func TestOCOIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateClosedOpenInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -252,10 +395,11 @@ func TestOGTIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateGreaterThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 1, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 1, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[0], 3, t)
+	AssertEqual(values[0], 3, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
 	AssertTrue(c.Contains(3), t)
@@ -271,13 +415,14 @@ func TestOALIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateAtLeastInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -291,7 +436,7 @@ func TestOLTIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateLessThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
@@ -308,13 +453,14 @@ func TestOAMIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateAtLeastInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -328,13 +474,14 @@ func TestOUIntervalIntersect(t *testing.T) {
 	a := GenerateOpenInterval(1, 4)
 	b := GenerateAtLeastInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -352,13 +499,14 @@ func TestCCIntervalIntersect(t *testing.T) {
 	a := GenerateClosedInterval(1, 4)
 	b := GenerateClosedInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 3, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 3, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[2], 4, t)
+	AssertEqual(values[2], 4, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -372,13 +520,14 @@ func TestCOCIntervalIntersect(t *testing.T) {
 	a := GenerateClosedInterval(1, 4)
 	b := GenerateOpenClosedInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[0], 3, t)
+	AssertEqual(values[0], 3, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[1], 4, t)
+	AssertEqual(values[1], 4, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -392,13 +541,14 @@ func TestCCOIntervalIntersect(t *testing.T) {
 	a := GenerateClosedInterval(1, 4)
 	b := GenerateClosedOpenInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 3, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 3, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -412,13 +562,14 @@ func TestCGTIntervalIntersect(t *testing.T) {
 	a := GenerateClosedInterval(1, 4)
 	b := GenerateClosedOpenInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 3, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 3, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[2], 4, t)
+	AssertEqual(values[2], 4, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -432,13 +583,14 @@ func TestCATIntervalIntersect(t *testing.T) {
 	a := GenerateClosedInterval(1, 4)
 	b := GenerateAtLeastInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 3, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 3, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[2], 4, t)
+	AssertEqual(values[2], 4, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
@@ -452,16 +604,17 @@ func TestCLTIntervalIntersect(t *testing.T) {
 	a := GenerateClosedInterval(1, 4)
 	b := GenerateLessThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 1, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 1, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 1, t)
+	AssertEqual(values[0], 1, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
 	AssertTrue(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
-	AssertEqual(c.String(), "[2,4)", t)
-	AssertEqual(c.SetNotation(), "{x | 2 ≤ x < 4}", t)
+	AssertEqual(c.String(), "[1,2)", t)
+	AssertEqual(c.SetNotation(), "{x | 1 ≤ x < 2}", t)
 	AssertEqual(c.Type, ClosedOpenInterval, t)
 }
 
@@ -470,13 +623,14 @@ func TestCAMIntervalIntersect(t *testing.T) {
 	a := GenerateClosedInterval(1, 4)
 	b := GenerateAtMostInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 1, t)
+	AssertEqual(values[0], 1, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[1], 2, t)
+	AssertEqual(values[1], 2, t)
 	AssertTrue(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "[1,2]", t)
@@ -489,13 +643,14 @@ func TestCUIntervalIntersect(t *testing.T) {
 	a := GenerateClosedInterval(1, 4)
 	b := GenerateUnboundedInterval[int]()
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 4, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 4, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 1, t)
+	AssertEqual(values[0], 1, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[3], 4, t)
+	AssertEqual(values[3], 4, t)
 	AssertTrue(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "[1,4]", t)
@@ -512,13 +667,14 @@ func TestOCOCIntervalIntersect(t *testing.T) {
 	a := GenerateOpenClosedInterval(1, 4)
 	b := GenerateOpenClosedInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[0], 3, t)
+	AssertEqual(values[0], 3, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[1], 4, t)
+	AssertEqual(values[1], 4, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "(2,4]", t)
@@ -531,13 +687,14 @@ func TestOCCOIntervalIntersect(t *testing.T) {
 	a := GenerateOpenClosedInterval(1, 4)
 	b := GenerateClosedOpenInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 3, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 3, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[2], 4, t)
+	AssertEqual(values[2], 4, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "[2,4]", t)
@@ -550,13 +707,14 @@ func TestOCGTIntervalIntersect(t *testing.T) {
 	a := GenerateOpenClosedInterval(1, 4)
 	b := GenerateGreaterThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[0], 3, t)
+	AssertEqual(values[0], 3, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[1], 4, t)
+	AssertEqual(values[1], 4, t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "(2,4]", t)
@@ -569,13 +727,14 @@ func TestOCALIntervalIntersect(t *testing.T) {
 	a := GenerateOpenClosedInterval(1, 4)
 	b := GenerateAtLeastInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 3, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 3, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[2], 4, t)
+	AssertEqual(values[2], 4, t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "[2,4]", t)
@@ -588,7 +747,7 @@ func TestOCLTIntervalIntersect(t *testing.T) {
 	a := GenerateOpenClosedInterval(1, 4)
 	b := GenerateLessThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
@@ -605,12 +764,13 @@ func TestOCAMIntervalIntersect(t *testing.T) {
 	a := GenerateOpenClosedInterval(1, 4)
 	b := GenerateAtMostInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 1, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 1, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertFalse(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "(1,2]", t)
@@ -623,13 +783,14 @@ func TestOCUIntervalIntersect(t *testing.T) {
 	a := GenerateOpenClosedInterval(1, 4)
 	b := GenerateUnboundedInterval[int]()
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 3, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 3, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
-	AssertEqual(c.Values[2], 4, t)
+	AssertEqual(values[0], 2, t)
+	AssertEqual(values[2], 4, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(1), t)
 	AssertEqual(c.String(), "(1,4]", t)
@@ -646,13 +807,14 @@ func TestCOCOIntervalIntersect(t *testing.T) {
 	a := GenerateClosedOpenInterval(1, 4)
 	b := GenerateClosedOpenInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "[2,4)", t)
@@ -665,10 +827,11 @@ func TestCOGTIntervalIntersect(t *testing.T) {
 	a := GenerateClosedOpenInterval(1, 4)
 	b := GenerateGreaterThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 1, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 1, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[0], 3, t)
+	AssertEqual(values[0], 3, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
 	AssertTrue(c.Contains(3), t)
@@ -683,13 +846,14 @@ func TestCOALIntervalIntersect(t *testing.T) {
 	a := GenerateClosedOpenInterval(1, 4)
 	b := GenerateAtLeastInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "[2,4)", t)
@@ -702,10 +866,11 @@ func TestCOLTIntervalIntersect(t *testing.T) {
 	a := GenerateClosedOpenInterval(1, 4)
 	b := GenerateLessThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 1, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 1, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 1, t)
+	AssertEqual(values[0], 1, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
 	AssertFalse(c.Contains(2), t)
@@ -720,13 +885,14 @@ func TestCOAMIntervalIntersect(t *testing.T) {
 	a := GenerateClosedOpenInterval(1, 4)
 	b := GenerateAtMostInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 1, t)
+	AssertEqual(values[0], 1, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[1], 2, t)
+	AssertEqual(values[1], 2, t)
 	AssertTrue(c.Contains(1), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "[1,2]", t)
@@ -739,13 +905,14 @@ func TestCOUIntervalIntersect(t *testing.T) {
 	a := GenerateClosedOpenInterval(1, 4)
 	b := GenerateClosedOpenInterval(2, 5)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 2, t)
+	values := collect(c.Values())
+	AssertEqual(len(values), 2, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.Values[0], 2, t)
+	AssertEqual(values[0], 2, t)
 	AssertEqual(c.UpperBound.Value, 4, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
-	AssertEqual(c.Values[1], 3, t)
+	AssertEqual(values[1], 3, t)
 	AssertTrue(c.Contains(3), t)
 	AssertFalse(c.Contains(5), t)
 	AssertEqual(c.String(), "[2,4)", t)
@@ -762,11 +929,11 @@ func TestGTGTIntervalIntersect(t *testing.T) {
 	a := GenerateGreaterThanInterval(1)
 	b := GenerateGreaterThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(c.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(c.UpperBound.Value, 0, t)
+	AssertEqual(c.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertFalse(c.Contains(1), t)
 	AssertTrue(c.Contains(5), t)
 	AssertEqual(c.String(), "(2,+∞)", t)
@@ -779,11 +946,11 @@ func TestGTALIntervalIntersect(t *testing.T) {
 	a := GenerateGreaterThanInterval(1)
 	b := GenerateAtLeastInterval(1)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(c.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(c.UpperBound.Value, 0, t)
+	AssertEqual(c.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertFalse(c.Contains(1), t)
 	AssertTrue(c.Contains(5), t)
 	AssertEqual(c.String(), "(1,+∞)", t)
@@ -796,7 +963,7 @@ func TestGTLTIntervalIntersect(t *testing.T) {
 	a := GenerateGreaterThanInterval(1)
 	b := GenerateLessThanInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
@@ -813,7 +980,7 @@ func TestGTAMIntervalIntersect(t *testing.T) {
 	a := GenerateGreaterThanInterval(1)
 	b := GenerateAtMostInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 1, t)
+	AssertEqual(len(collect(c.Values())), 1, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
@@ -830,11 +997,11 @@ func TestGTUIntervalIntersect(t *testing.T) {
 	a := GenerateGreaterThanInterval(1)
 	b := GenerateUnboundedInterval[int]()
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 1, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
-	AssertEqual(c.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(c.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(c.UpperBound.Value, 0, t)
+	AssertEqual(c.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertFalse(c.Contains(1), t)
 	AssertTrue(c.Contains(5), t)
 	AssertEqual(c.String(), "(1,+∞)", t)
@@ -851,15 +1018,15 @@ func TestALALIntervalIntersect(t *testing.T) {
 	a := GenerateAtLeastInterval(2)
 	b := GenerateAtLeastInterval(1)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(c.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(c.UpperBound.Value, 0, t)
+	AssertEqual(c.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertFalse(c.Contains(1), t)
 	AssertTrue(c.Contains(5), t)
-	AssertEqual(c.String(), "[1,+∞)", t)
-	AssertEqual(c.SetNotation(), "{x | x ≥ 1}", t)
+	AssertEqual(c.String(), "[2,+∞)", t)
+	AssertEqual(c.SetNotation(), "{x | x ≥ 2}", t)
 	AssertEqual(c.Type, AtLeastInterval, t)
 }
 
@@ -868,7 +1035,7 @@ func TestALLTIntervalIntersect(t *testing.T) {
 	a := GenerateAtLeastInterval(2)
 	b := GenerateLessThanInterval(1)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 0, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
 	AssertEqual(c.UpperBound.Value, 0, t)
@@ -885,7 +1052,7 @@ func TestALAMIntervalIntersect(t *testing.T) {
 	a := GenerateAtLeastInterval(2)
 	b := GenerateAtMostInterval(1)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 0, t)
 	AssertEqual(c.LowerBound.Type, OpenPoint, t)
 	AssertEqual(c.UpperBound.Value, 0, t)
@@ -902,11 +1069,11 @@ func TestALUIntervalIntersect(t *testing.T) {
 	a := GenerateAtLeastInterval(2)
 	b := GenerateUnboundedInterval[int]()
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
 	AssertEqual(c.LowerBound.Value, 2, t)
 	AssertEqual(c.LowerBound.Type, ClosedPoint, t)
-	AssertEqual(c.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(c.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(c.UpperBound.Value, 0, t)
+	AssertEqual(c.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertFalse(c.Contains(1), t)
 	AssertTrue(c.Contains(2), t)
 	AssertEqual(c.String(), "[2,+∞)", t)
@@ -923,9 +1090,9 @@ func TestLTLTIntervalIntersect(t *testing.T) {
 	a := GenerateLessThanInterval(2)
 	b := GenerateLessThanInterval(1)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
-	AssertEqual(c.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(c.LowerBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
+	AssertEqual(c.LowerBound.Value, 0, t)
+	AssertEqual(c.LowerBound.Type, NegativeUnboundedPoint, t)
 	AssertEqual(c.UpperBound.Value, 1, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
 	AssertFalse(c.Contains(1), t)
@@ -940,9 +1107,9 @@ func TestLTAMIntervalIntersect(t *testing.T) {
 	a := GenerateLessThanInterval(2)
 	b := GenerateAtMostInterval(1)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
-	AssertEqual(c.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(c.LowerBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
+	AssertEqual(c.LowerBound.Value, 0, t)
+	AssertEqual(c.LowerBound.Type, NegativeUnboundedPoint, t)
 	AssertEqual(c.UpperBound.Value, 1, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
 	AssertTrue(c.Contains(1), t)
@@ -957,9 +1124,9 @@ func TestLTUIntervalIntersect(t *testing.T) {
 	a := GenerateLessThanInterval(2)
 	b := GenerateUnboundedInterval[int]()
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
-	AssertEqual(c.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(c.LowerBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
+	AssertEqual(c.LowerBound.Value, 0, t)
+	AssertEqual(c.LowerBound.Type, NegativeUnboundedPoint, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
 	AssertEqual(c.UpperBound.Type, OpenPoint, t)
 	AssertTrue(c.Contains(1), t)
@@ -978,15 +1145,15 @@ func TestAMAMIntervalIntersect(t *testing.T) {
 	a := GenerateAtMostInterval(1)
 	b := GenerateAtMostInterval(2)
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
-	AssertEqual(c.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(c.LowerBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
+	AssertEqual(c.LowerBound.Value, 0, t)
+	AssertEqual(c.LowerBound.Type, NegativeUnboundedPoint, t)
 	AssertEqual(c.UpperBound.Value, 1, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
 	AssertTrue(c.Contains(1), t)
 	AssertFalse(c.Contains(2), t)
-	AssertEqual(c.String(), "(-∞,2)", t)
-	AssertEqual(c.SetNotation(), "{x | x ≤ 2}", t)
+	AssertEqual(c.String(), "(-∞,1]", t)
+	AssertEqual(c.SetNotation(), "{x | x ≤ 1}", t)
 	AssertEqual(c.Type, AtMostInterval, t)
 }
 
@@ -995,9 +1162,9 @@ func TestAMUIntervalIntersect(t *testing.T) {
 	a := GenerateAtMostInterval(2)
 	b := GenerateUnboundedInterval[int]()
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
-	AssertEqual(c.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(c.LowerBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
+	AssertEqual(c.LowerBound.Value, 0, t)
+	AssertEqual(c.LowerBound.Type, NegativeUnboundedPoint, t)
 	AssertEqual(c.UpperBound.Value, 2, t)
 	AssertEqual(c.UpperBound.Type, ClosedPoint, t)
 	AssertTrue(c.Contains(2), t)
@@ -1016,11 +1183,11 @@ func TestUUIntervalIntersect(t *testing.T) {
 	a := GenerateUnboundedInterval[int]()
 	b := GenerateUnboundedInterval[int]()
 	c := Intersect(a, b)
-	AssertEqual(len(c.Values), 0, t)
-	AssertEqual(c.LowerBound.Value, int(math.Inf(-1)), t)
-	AssertEqual(c.LowerBound.Type, UnboundedPoint, t)
-	AssertEqual(c.UpperBound.Value, int(math.Inf(0)), t)
-	AssertEqual(c.UpperBound.Type, UnboundedPoint, t)
+	AssertEqual(len(collect(c.Values())), 0, t)
+	AssertEqual(c.LowerBound.Value, 0, t)
+	AssertEqual(c.LowerBound.Type, NegativeUnboundedPoint, t)
+	AssertEqual(c.UpperBound.Value, 0, t)
+	AssertEqual(c.UpperBound.Type, PositiveUnboundedPoint, t)
 	AssertTrue(c.Contains(1000000), t)
 	AssertTrue(c.Contains(-1000000), t)
 	AssertEqual(c.String(), "(-∞,+∞)", t)
@@ -1030,4 +1197,253 @@ func TestUUIntervalIntersect(t *testing.T) {
 
 /* !SECTION: Unbounded Intersection */
 
+/* SECTION: Touching Boundary Intersection */
+
+/* Intervals that share an endpoint only intersect when both of them include it */
+func TestTouchingIntervalIntersect(t *testing.T) {
+	tests := []struct {
+		name         string
+		a, b         Interval[int]
+		notation     string
+		intervalType IntervalType
+	}{
+		{"[1,2] ∩ [2,3]", GenerateClosedInterval(1, 2), GenerateClosedInterval(2, 3), "[2,2]", DegenerateInterval},
+		{"[1,2] ∩ (2,3]", GenerateClosedInterval(1, 2), GenerateOpenClosedInterval(2, 3), "{}", EmptyInterval},
+		{"[1,2) ∩ [2,3]", GenerateClosedOpenInterval(1, 2), GenerateClosedInterval(2, 3), "{}", EmptyInterval},
+		{"[1,2) ∩ (2,3]", GenerateClosedOpenInterval(1, 2), GenerateOpenClosedInterval(2, 3), "{}", EmptyInterval},
+		{"[2,3] ∩ [1,2]", GenerateClosedInterval(2, 3), GenerateClosedInterval(1, 2), "[2,2]", DegenerateInterval},
+		{"(2,3] ∩ [1,2]", GenerateOpenClosedInterval(2, 3), GenerateClosedInterval(1, 2), "{}", EmptyInterval},
+		{"(-∞,2] ∩ [2,+∞)", GenerateAtMostInterval(2), GenerateAtLeastInterval(2), "[2,2]", DegenerateInterval},
+		{"(-∞,2) ∩ [2,+∞)", GenerateLessThanInterval(2), GenerateAtLeastInterval(2), "{}", EmptyInterval},
+		{"(-∞,2] ∩ (2,+∞)", GenerateAtMostInterval(2), GenerateGreaterThanInterval(2), "{}", EmptyInterval},
+		{"(-∞,2) ∩ (2,+∞)", GenerateLessThanInterval(2), GenerateGreaterThanInterval(2), "{}", EmptyInterval},
+		{"[1,2] ∩ (-∞,1]", GenerateClosedInterval(1, 2), GenerateAtMostInterval(1), "[1,1]", DegenerateInterval},
+		{"(1,2] ∩ (-∞,1]", GenerateOpenClosedInterval(1, 2), GenerateAtMostInterval(1), "{}", EmptyInterval},
+		{"[2,3] ∩ (2,5)", GenerateClosedInterval(2, 3), GenerateOpenInterval(2, 5), "(2,3]", OpenClosedInterval},
+		{"[1,3) ∩ [2,3]", GenerateClosedOpenInterval(1, 3), GenerateClosedInterval(2, 3), "[2,3)", ClosedOpenInterval},
+		{"(1,3] ∩ [1,3)", GenerateOpenClosedInterval(1, 3), GenerateClosedOpenInterval(1, 3), "(1,3)", OpenInterval},
+		{"[1,3] ∩ [1,3]", GenerateClosedInterval(1, 3), GenerateClosedInterval(1, 3), "[1,3]", ClosedInterval},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := Intersect(test.a, test.b)
+			AssertEqual(c.String(), test.notation, t)
+			AssertEqual(c.Type, test.intervalType, t)
+		})
+	}
+}
+
+func TestDegenerateIntervalContains(t *testing.T) {
+	c := Intersect(GenerateClosedInterval(1, 2), GenerateClosedInterval(2, 3))
+	AssertTrue(c.Contains(2), t)
+	AssertFalse(c.Contains(1), t)
+	AssertFalse(c.Contains(3), t)
+}
+
+/* !SECTION: Touching Boundary Intersection */
+
 /* !SECTION: Intersection Testing */
+
+/* SECTION: Set Operation Testing */
+
+func TestUnion(t *testing.T) {
+	AssertEqual(Union(GenerateClosedOpenInterval(1, 3), GenerateOpenClosedInterval(5, 9)).String(), "[1,3) ∪ (5,9]", t)
+	AssertEqual(Union(GenerateClosedOpenInterval(1, 3), GenerateClosedInterval(3, 9)).String(), "[1,9]", t)
+	AssertEqual(Union(GenerateOpenInterval(1, 3), GenerateOpenInterval(3, 9)).String(), "(1,3) ∪ (3,9)", t)
+	AssertEqual(Union(GenerateOpenInterval(1, 5), GenerateEmptyInterval[int]()).String(), "(1,5)", t)
+	AssertEqual(Union(GenerateLessThanInterval(2.0), GenerateGreaterThanInterval(2.0)).String(), "(-∞,2) ∪ (2,+∞)", t)
+	AssertEqual(Union(GenerateAtMostInterval(2.0), GenerateGreaterThanInterval(2.0)).String(), "(-∞,+∞)", t)
+	AssertEqual(Union(GenerateAtLeastInterval(1.0), GenerateUnboundedInterval[float64]()).String(), "(-∞,+∞)", t)
+}
+
+func TestDifference(t *testing.T) {
+	AssertEqual(Difference(GenerateClosedInterval(1, 9), GenerateOpenInterval(3, 5)).String(), "[1,3] ∪ [5,9]", t)
+	AssertEqual(Difference(GenerateClosedInterval(1, 9), GenerateClosedInterval(3, 5)).String(), "[1,3) ∪ (5,9]", t)
+	AssertEqual(Difference(GenerateClosedInterval(1, 9), GenerateClosedInterval(0, 10)).String(), "{}", t)
+	AssertEqual(Difference(GenerateClosedInterval(1, 9), GenerateClosedInterval(20, 30)).String(), "[1,9]", t)
+	AssertEqual(Difference(GenerateClosedOpenInterval(1, 9), GenerateOpenClosedInterval(5, 9)).String(), "[1,5]", t)
+	AssertEqual(Difference(GenerateClosedInterval(1, 9), GenerateEmptyInterval[int]()).String(), "[1,9]", t)
+	AssertEqual(Difference(GenerateEmptyInterval[int](), GenerateClosedInterval(1, 9)).String(), "{}", t)
+	AssertEqual(Difference(GenerateUnboundedInterval[float64](), GenerateAtMostInterval(2.0)).String(), "(2,+∞)", t)
+	AssertEqual(Difference(GenerateAtLeastInterval(1.0), GenerateGreaterThanInterval(4.0)).String(), "[1,4]", t)
+	AssertEqual(Difference(GenerateLessThanInterval(4.0), GenerateClosedInterval(0.0, 1.0)).String(), "(-∞,0) ∪ (1,4)", t)
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	AssertEqual(SymmetricDifference(GenerateClosedInterval(1, 5), GenerateClosedInterval(3, 9)).String(), "[1,3) ∪ (5,9]", t)
+	AssertEqual(SymmetricDifference(GenerateClosedInterval(1, 5), GenerateClosedInterval(1, 5)).String(), "{}", t)
+	AssertEqual(SymmetricDifference(GenerateClosedOpenInterval(1, 5), GenerateClosedInterval(5, 9)).String(), "[1,9]", t)
+	AssertEqual(SymmetricDifference(GenerateAtMostInterval(5.0), GenerateAtLeastInterval(1.0)).String(), "(-∞,1) ∪ (5,+∞)", t)
+}
+
+func TestComplement(t *testing.T) {
+	AssertEqual(Complement(GenerateClosedOpenInterval(2.0, 5.0)).String(), "(-∞,2) ∪ [5,+∞)", t)
+	AssertEqual(Complement(GenerateOpenInterval(2.0, 5.0)).String(), "(-∞,2] ∪ [5,+∞)", t)
+	AssertEqual(Complement(GenerateClosedInterval(2.0, 5.0)).String(), "(-∞,2) ∪ (5,+∞)", t)
+	AssertEqual(Complement(GenerateOpenClosedInterval(2.0, 5.0)).String(), "(-∞,2] ∪ (5,+∞)", t)
+	AssertEqual(Complement(GenerateClosedInterval(2.0, 2.0)).String(), "(-∞,2) ∪ (2,+∞)", t)
+	AssertEqual(Complement(GenerateGreaterThanInterval(2.0)).String(), "(-∞,2]", t)
+	AssertEqual(Complement(GenerateAtLeastInterval(2.0)).String(), "(-∞,2)", t)
+	AssertEqual(Complement(GenerateLessThanInterval(2.0)).String(), "[2,+∞)", t)
+	AssertEqual(Complement(GenerateAtMostInterval(2.0)).String(), "(2,+∞)", t)
+	AssertEqual(Complement(GenerateUnboundedInterval[float64]()).String(), "{}", t)
+	AssertEqual(Complement(GenerateEmptyInterval[float64]()).String(), "(-∞,+∞)", t)
+}
+
+/* !SECTION: Set Operation Testing */
+
+/* SECTION: Hull Testing */
+
+func TestHull(t *testing.T) {
+	tests := []struct {
+		a        Interval[int]
+		b        Interval[int]
+		expected string
+	}{
+		{GenerateClosedOpenInterval(1, 3), GenerateOpenClosedInterval(5, 8), "[1,8]"},
+		{GenerateOpenInterval(1, 3), GenerateClosedInterval(1, 2), "[1,3)"},
+		{GenerateOpenInterval(1, 5), GenerateOpenClosedInterval(2, 5), "(1,5]"},
+		{GenerateClosedInterval(2, 2), GenerateClosedInterval(7, 7), "[2,7]"},
+		{GenerateLessThanInterval(0), GenerateClosedInterval(4, 6), "(-∞,6]"},
+		{GenerateAtMostInterval(0), GenerateGreaterThanInterval(9), "(-∞,+∞)"},
+		{GenerateEmptyInterval[int](), GenerateOpenInterval(1, 3), "(1,3)"},
+		{GenerateOpenInterval(1, 3), GenerateEmptyInterval[int](), "(1,3)"},
+		{GenerateEmptyInterval[int](), GenerateEmptyInterval[int](), "{}"},
+	}
+	for _, test := range tests {
+		hull := Hull(test.a, test.b)
+		AssertEqual(hull.String(), test.expected, t)
+		hull = Hull(test.b, test.a)
+		AssertEqual(hull.String(), test.expected, t)
+	}
+}
+
+func TestSpan(t *testing.T) {
+	span := Span[int]()
+	AssertEqual(span.Type, EmptyInterval, t)
+
+	span = Span(GenerateOpenInterval(4, 6), GenerateEmptyInterval[int](), GenerateClosedOpenInterval(-2, 0), GenerateOpenClosedInterval(5, 6))
+	AssertEqual(span.String(), "[-2,6]", t)
+
+	floatSpan := Span(GenerateClosedInterval(1.5, 2.5), GenerateGreaterThanInterval(0.5))
+	AssertEqual(floatSpan.String(), "(0.5,+∞)", t)
+}
+
+func TestGap(t *testing.T) {
+	tests := []struct {
+		a        Interval[int]
+		b        Interval[int]
+		expected string
+	}{
+		{GenerateClosedOpenInterval(1, 3), GenerateOpenClosedInterval(5, 8), "[3,5]"},
+		{GenerateClosedInterval(1, 3), GenerateClosedInterval(5, 8), "(3,5)"},
+		{GenerateOpenInterval(1, 2), GenerateOpenInterval(2, 3), "[2,2]"},
+		{GenerateClosedOpenInterval(1, 2), GenerateClosedInterval(2, 3), "{}"},
+		{GenerateClosedInterval(1, 4), GenerateClosedInterval(3, 5), "{}"},
+		{GenerateLessThanInterval(0), GenerateAtLeastInterval(4), "[0,4)"},
+		{GenerateEmptyInterval[int](), GenerateClosedInterval(3, 5), "{}"},
+	}
+	for _, test := range tests {
+		gap := Gap(test.a, test.b)
+		AssertEqual(gap.String(), test.expected, t)
+		gap = Gap(test.b, test.a)
+		AssertEqual(gap.String(), test.expected, t)
+	}
+	/* an adjacent pair records where the gap would have been */
+	gap, origin := GapOrigin(GenerateClosedOpenInterval(1, 2), GenerateClosedInterval(2, 3))
+	AssertEqual(gap.Type, EmptyInterval, t)
+	AssertEqual(origin.String(), "[2,2)", t)
+}
+
+/* !SECTION: Hull Testing */
+
+/* SECTION: Value Semantics Testing */
+
+func TestIntervalComparable(t *testing.T) {
+	AssertTrue(GenerateClosedOpenInterval(1, 5) == GenerateClosedOpenInterval(1, 5), t)
+	AssertTrue(GenerateClosedOpenInterval(1, 5) != GenerateClosedInterval(1, 5), t)
+	AssertTrue(GenerateEmptyInterval[int]() == GenerateEmptyInterval[int](), t)
+	/* every Empty Interval is the same value, whatever endpoints it was derived from */
+	AssertTrue(GenerateOpenInterval(2, 2) == GenerateOpenInterval(3, 3), t)
+	AssertTrue(Intersect(GenerateClosedOpenInterval(1, 2), GenerateClosedInterval(2, 3)) == GenerateEmptyInterval[int](), t)
+
+	seen := map[Interval[float64]]string{
+		GenerateOpenInterval(0.5, 1.5): "open",
+		GenerateAtMostInterval(2.0):    "at most",
+	}
+	AssertEqual(seen[GenerateOpenInterval(0.5, 1.5)], "open", t)
+	AssertEqual(seen[GenerateAtMostInterval(2.0)], "at most", t)
+	AssertEqual(len(seen), 2, t)
+
+	empties := map[Interval[int]]bool{GenerateOpenInterval(2, 2): true, GenerateClosedOpenInterval(7, 7): true}
+	AssertEqual(len(empties), 1, t)
+}
+
+func TestIntervalZeroAllocations(t *testing.T) {
+	a, b := GenerateClosedOpenInterval(1, 1_000_000_000), GenerateGreaterThanInterval(500)
+	tests := []struct {
+		name string
+		run  func()
+	}{
+		{"GenerateClosedInterval", func() { intervalSink = GenerateClosedInterval(0, 1_000_000_000) }},
+		{"NewOpenClosed", func() { intervalSink, _ = NewOpenClosed(0, 1_000_000_000) }},
+		{"GenerateUnboundedInterval", func() { intervalSink = GenerateUnboundedInterval[int]() }},
+		{"Contains", func() { boolSink = a.Contains(42) }},
+		{"Intersect", func() { intervalSink = Intersect(a, b) }},
+		{"Intersect Empty", func() { intervalSink = Intersect(a, GenerateLessThanInterval(0)) }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			AssertEqual(testing.AllocsPerRun(100, test.run), 0.0, t)
+		})
+	}
+}
+
+/* !SECTION: Value Semantics Testing */
+
+/* SECTION: Benchmarks */
+
+var (
+	intervalSink Interval[int]
+	boolSink     bool
+)
+
+func BenchmarkGenerateClosedInterval(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intervalSink = GenerateClosedInterval(0, 1_000_000_000)
+	}
+}
+
+func BenchmarkNewOpenClosed(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intervalSink, _ = NewOpenClosed(i, i+10)
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	interval := GenerateClosedOpenInterval(0, 1_000_000_000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		boolSink = interval.Contains(i)
+	}
+}
+
+func BenchmarkIntersect(b *testing.B) {
+	x, y := GenerateClosedOpenInterval(0, 1_000_000_000), GenerateOpenClosedInterval(500, 2_000_000_000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intervalSink = Intersect(x, y)
+	}
+}
+
+func BenchmarkIntersectEmpty(b *testing.B) {
+	x, y := GenerateClosedOpenInterval(0, 10), GenerateClosedInterval(10, 20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intervalSink = Intersect(x, y)
+	}
+}
+
+/* !SECTION: Benchmarks */
@@ -0,0 +1,183 @@
+package interval
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+/*
+	Public Method that returns an iterator over the integer Values of a bounded interval in ascending order.
+	The Values are produced lazily, so iterating over the first few Values of [0,1000000000] is cheap, and
+	iteration stops as soon as yield returns false. Empty and unbounded intervals yield nothing.
+
+	Only integral Values are yielded, for float intervals too: [0.5,3] yields 1, 2 and 3. Use StepBy or
+	Linspace to walk a float interval on a finer grid.
+
+	The iterator has the shape of iter.Seq[N] and is called with the function to yield to:
+
+		interval.Values()(func(n N) bool { ...; return true })
+
+	Return:
+		func(yield func(N) bool)
+*/
+func (self Interval[N]) Values() func(yield func(N) bool) {
+	return func(yield func(N) bool) {
+		first, last, ok := self.integerRange()
+		if !ok {
+			return
+		}
+		/* stop on the last Value rather than past it so that the increment can never overflow */
+		for n := first; yield(n) && n < last; n++ {
+			/* float types run out of integer precision at large magnitudes */
+			if n+1 == n {
+				return
+			}
+		}
+	}
+}
+
+/*
+	Public Method that returns an iterator over the index and Value of each integer Value of a bounded
+	interval, in the shape of iter.Seq2[int, N]. See Values.
+
+	Return:
+		func(yield func(int, N) bool)
+*/
+func (self Interval[N]) All() func(yield func(int, N) bool) {
+	return func(yield func(int, N) bool) {
+		index := 0
+		self.Values()(func(Value N) bool {
+			if !yield(index, Value) {
+				return false
+			}
+			index++
+			return true
+		})
+	}
+}
+
+/*
+	Public Method that returns an iterator over the integer Values of a bounded interval in descending order.
+	See Values.
+
+	Return:
+		func(yield func(N) bool)
+*/
+func (self Interval[N]) Backward() func(yield func(N) bool) {
+	return func(yield func(N) bool) {
+		first, last, ok := self.integerRange()
+		if !ok {
+			return
+		}
+		for n := last; yield(n) && n > first; n-- {
+			if n-1 == n {
+				return
+			}
+		}
+	}
+}
+
+/*
+	Public Method that returns an iterator over the grid a, a+step, a+2*step, … of Values inside the interval.
+
+	A positive step walks upwards from the LowerBound and a negative step walks downwards from the UpperBound.
+	The grid is anchored at the Value of that endpoint even when it is Open, in which case the endpoint itself
+	is skipped, so (0,1) with a step of 0.25 yields 0.25, 0.5 and 0.75. Float grid points are computed as
+	a+i*step rather than accumulated, so rounding errors do not build up along the walk.
+	An interval without a finite endpoint to start from yields nothing, ex: (-∞,3] with a positive step.
+	A half-line such as [0,+∞) is walked until yield returns false or the Values leave the range of N.
+
+	Parameters:
+		step N	non-zero and finite, a zero, NaN or infinite step yields nothing
+	Return:
+		func(yield func(N) bool)
+*/
+func (self Interval[N]) StepBy(step N) func(yield func(N) bool) {
+	return func(yield func(N) bool) {
+		if self.Type == EmptyInterval || step == 0 || isNaN(step) || isInf(step) {
+			return
+		}
+		anchor := self.LowerBound
+		if step < 0 {
+			anchor = self.UpperBound
+		}
+		if anchor.IsInfinite() {
+			return
+		}
+		var index int
+		if anchor.Type == OpenPoint {
+			index = 1
+		}
+		if isFloat[N]() {
+			for ; ; index++ {
+				Value := anchor.Value + N(index)*step
+				if isInf(Value) || !self.Contains(Value) || !yield(Value) {
+					return
+				}
+			}
+		}
+		Value := anchor.Value
+		if index == 1 {
+			if Value += step; (step > 0) != (Value > anchor.Value) {
+				return
+			}
+		}
+		for self.Contains(Value) && yield(Value) {
+			/* stop rather than wrap around when the next Value overflows N */
+			next := Value + step
+			if (step > 0) != (next > Value) {
+				return
+			}
+			Value = next
+		}
+	}
+}
+
+/*
+	Public Function that returns an iterator over n evenly spaced Values of a bounded float interval,
+	in the manner of numpy's linspace.
+
+	Closed endpoints are part of the grid and Open endpoints are not: [0,1] with n = 5 yields 0, 0.25, 0.5,
+	0.75 and 1, [0,1) with n = 4 yields 0, 0.25, 0.5 and 0.75, and (0,1) with n = 3 yields 0.25, 0.5 and 0.75.
+	Empty and unbounded intervals yield nothing.
+
+	Parameters:
+		interval Interval[F]
+		n int	number of Values, nothing is yielded when n < 1
+	Return:
+		func(yield func(F) bool)
+*/
+func Linspace[F constraints.Float](interval Interval[F], n int) func(yield func(F) bool) {
+	return func(yield func(F) bool) {
+		if n < 1 || interval.Type == EmptyInterval || interval.LowerBound.IsInfinite() || interval.UpperBound.IsInfinite() {
+			return
+		}
+		lower, upper := interval.LowerBound.Value, interval.UpperBound.Value
+		offset, segments := 0, n-1
+		if interval.LowerBound.Type == OpenPoint {
+			offset, segments = 1, segments+1
+		}
+		if interval.UpperBound.Type == OpenPoint {
+			segments++
+		}
+		if segments == 0 {
+			yield(lower)
+			return
+		}
+		spacing := (upper - lower) / F(segments)
+		/* upper - lower overflows for endpoints near the limits of F */
+		if math.IsInf(float64(spacing), 0) {
+			spacing = upper/F(segments) - lower/F(segments)
+		}
+		for i := 0; i < n; i++ {
+			Value := lower + F(i+offset)*spacing
+			if i+offset == segments {
+				Value = upper
+			}
+			if !yield(Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package interval
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+/* Private helper that gathers the Values of an iterator into a slice */
+func collect[N Numeric](seq func(yield func(N) bool)) []N {
+	var Values []N
+	seq(func(Value N) bool {
+		Values = append(Values, Value)
+		return true
+	})
+	return Values
+}
+
+/* SECTION: Iteration Testing */
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		interval Interval[int]
+		expected []int
+	}{
+		{GenerateEmptyInterval[int](), nil},
+		{GenerateClosedInterval(3, 3), []int{3}},
+		{GenerateOpenInterval(1, 2), nil},
+		{GenerateOpenInterval(1, 5), []int{2, 3, 4}},
+		{GenerateClosedInterval(-1, 2), []int{-1, 0, 1, 2}},
+		{GenerateOpenClosedInterval(1, 3), []int{2, 3}},
+		{GenerateClosedOpenInterval(1, 3), []int{1, 2}},
+		{GenerateAtLeastInterval(1), nil},
+		{GenerateUnboundedInterval[int](), nil},
+	}
+	for _, test := range tests {
+		AssertTrue(slices.Equal(collect(test.interval.Values()), test.expected), t)
+	}
+}
+
+func TestFloatValues(t *testing.T) {
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval(0.5, 3.5).Values()), []float64{1, 2, 3}), t)
+	AssertTrue(slices.Equal(collect(GenerateOpenInterval(1.0, 3.0).Values()), []float64{2}), t)
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval(1.0, 3.0).Values()), []float64{1, 2, 3}), t)
+	AssertEqual(len(collect(GenerateOpenInterval(0.25, 0.75).Values())), 0, t)
+}
+
+func TestValuesAtTypeLimits(t *testing.T) {
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval[int8](125, 127).Values()), []int8{125, 126, 127}), t)
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval[uint8](0, 2).Values()), []uint8{0, 1, 2}), t)
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval[uint8](253, 255).Values()), []uint8{253, 254, 255}), t)
+	interval := GenerateClosedInterval[int8](-128, 127)
+	AssertEqual(interval.Count(), 256, t)
+}
+
+func TestValuesEarlyBreak(t *testing.T) {
+	interval := GenerateClosedInterval(0, math.MaxInt)
+	var Values []int
+	interval.Values()(func(Value int) bool {
+		Values = append(Values, Value)
+		return len(Values) < 3
+	})
+	AssertTrue(slices.Equal(Values, []int{0, 1, 2}), t)
+}
+
+func TestAll(t *testing.T) {
+	interval := GenerateOpenClosedInterval(10, 1_000_000_000)
+	var indexes, Values []int
+	interval.All()(func(index int, Value int) bool {
+		indexes = append(indexes, index)
+		Values = append(Values, Value)
+		return index < 2
+	})
+	AssertTrue(slices.Equal(indexes, []int{0, 1, 2}), t)
+	AssertTrue(slices.Equal(Values, []int{11, 12, 13}), t)
+	AssertEqual(interval.Count(), 999_999_990, t)
+}
+
+func TestBackward(t *testing.T) {
+	AssertTrue(slices.Equal(collect(GenerateOpenClosedInterval(1, 4).Backward()), []int{4, 3, 2}), t)
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval[uint8](0, 2).Backward()), []uint8{2, 1, 0}), t)
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval(0.5, 2.5).Backward()), []float64{2, 1}), t)
+	AssertEqual(len(collect(GenerateAtMostInterval(3).Backward())), 0, t)
+}
+
+func TestStepBy(t *testing.T) {
+	tests := []struct {
+		interval Interval[int]
+		step     int
+		expected []int
+	}{
+		{GenerateClosedInterval(0, 10), 3, []int{0, 3, 6, 9}},
+		{GenerateOpenInterval(0, 9), 3, []int{3, 6}},
+		{GenerateClosedInterval(0, 10), -3, []int{10, 7, 4, 1}},
+		{GenerateClosedOpenInterval(0, 10), -5, []int{5, 0}},
+		{GenerateClosedInterval(2, 2), 4, []int{2}},
+		{GenerateOpenInterval(0, 2), 5, nil},
+		{GenerateAtMostInterval(3), 1, nil},
+		{GenerateAtMostInterval(3), -2, []int{3, 1, -1, -3}},
+		{GenerateEmptyInterval[int](), 1, nil},
+	}
+	for _, test := range tests {
+		var Values []int
+		test.interval.StepBy(test.step)(func(Value int) bool {
+			Values = append(Values, Value)
+			return len(Values) < 4
+		})
+		AssertTrue(slices.Equal(Values, test.expected), t)
+	}
+}
+
+func TestStepByAtTypeLimits(t *testing.T) {
+	AssertTrue(slices.Equal(collect(GenerateAtLeastInterval[int8](120).StepBy(3)), []int8{120, 123, 126}), t)
+	AssertTrue(slices.Equal(collect(GenerateGreaterThanInterval[int8](127).StepBy(1)), []int8(nil)), t)
+	AssertTrue(slices.Equal(collect(GenerateAtMostInterval[int8](-120).StepBy(-4)), []int8{-120, -124, -128}), t)
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval[uint8](250, 255).StepBy(2)), []uint8{250, 252, 254}), t)
+}
+
+func TestFloatStepBy(t *testing.T) {
+	AssertTrue(slices.Equal(collect(GenerateOpenInterval(0.0, 1.0).StepBy(0.25)), []float64{0.25, 0.5, 0.75}), t)
+	AssertTrue(slices.Equal(collect(GenerateClosedInterval(0.0, 1.0).StepBy(0.25)), []float64{0, 0.25, 0.5, 0.75, 1}), t)
+	AssertTrue(slices.Equal(collect(GenerateClosedOpenInterval(0.0, 1.0).StepBy(-0.5)), []float64{0.5, 0}), t)
+
+	/* grid points are not accumulated: the tenth step of 0.1 lands exactly on the Open endpoint 1 */
+	step := 0.1
+	Values := collect(GenerateOpenInterval(0.0, 1.0).StepBy(step))
+	AssertEqual(len(Values), 9, t)
+	AssertEqual(Values[2], 3*step, t)
+}
+
+func TestStepByInvalidStep(t *testing.T) {
+	for _, step := range []float64{0, math.NaN(), math.Inf(1), math.Inf(-1)} {
+		AssertEqual(len(collect(GenerateClosedInterval(0.0, 1.0).StepBy(step))), 0, t)
+	}
+	AssertEqual(len(collect(GenerateClosedInterval(0, 10).StepBy(0))), 0, t)
+}
+
+func TestLinspace(t *testing.T) {
+	tests := []struct {
+		interval Interval[float64]
+		n        int
+		expected []float64
+	}{
+		{GenerateClosedInterval(0.0, 1.0), 5, []float64{0, 0.25, 0.5, 0.75, 1}},
+		{GenerateClosedOpenInterval(0.0, 1.0), 4, []float64{0, 0.25, 0.5, 0.75}},
+		{GenerateOpenClosedInterval(0.0, 1.0), 4, []float64{0.25, 0.5, 0.75, 1}},
+		{GenerateOpenInterval(0.0, 1.0), 3, []float64{0.25, 0.5, 0.75}},
+		{GenerateClosedInterval(-1.0, 1.0), 1, []float64{-1}},
+		{GenerateClosedInterval(2.0, 2.0), 2, []float64{2, 2}},
+		{GenerateClosedInterval(0.0, 1.0), 0, nil},
+		{GenerateAtLeastInterval(0.0), 3, nil},
+		{GenerateEmptyInterval[float64](), 3, nil},
+		{GenerateClosedInterval(-math.MaxFloat64, math.MaxFloat64), 3, []float64{-math.MaxFloat64, 0, math.MaxFloat64}},
+	}
+	for _, test := range tests {
+		AssertTrue(slices.Equal(collect(Linspace(test.interval, test.n)), test.expected), t)
+	}
+
+	/* the last grid point is the UpperBound itself, not an approximation of it */
+	Values := collect(Linspace(GenerateClosedInterval(0.0, 0.3), 4))
+	AssertEqual(Values[3], 0.3, t)
+}
+
+/* !SECTION: Iteration Testing */
@@ -0,0 +1,431 @@
+package interval
+
+import "math"
+
+/* the number of Krawczyk steps that narrow an enclosure before Krawczyk returns it */
+const maxKrawczykSteps = 32
+
+/* IntervalVector Type for a vector of float64 intervals */
+type IntervalVector []Interval[float64]
+
+/* IntervalMatrix Type for a matrix of float64 intervals, as a slice of rows */
+type IntervalMatrix [][]Interval[float64]
+
+/* SECTION: Vector and Matrix Construction */
+
+/* Public Function that returns the IntervalVector of Degenerate intervals holding the Values */
+func NewPointVector(Values ...float64) IntervalVector {
+	vector := make(IntervalVector, len(Values))
+	for i, Value := range Values {
+		vector[i] = GenerateClosedInterval(Value, Value)
+	}
+	return vector
+}
+
+/* Public Function that returns the IntervalMatrix of Degenerate intervals holding the rows of Values */
+func NewPointMatrix(rows [][]float64) IntervalMatrix {
+	matrix := make(IntervalMatrix, len(rows))
+	for i, row := range rows {
+		matrix[i] = NewPointVector(row...)
+	}
+	return matrix
+}
+
+/* Public Function that returns the n × n identity IntervalMatrix */
+func NewIdentityMatrix(n int) IntervalMatrix {
+	rows := make([][]float64, n)
+	for i := range rows {
+		rows[i] = make([]float64, n)
+		rows[i][i] = 1
+	}
+	return NewPointMatrix(rows)
+}
+
+/* !SECTION: Vector and Matrix Construction */
+
+/* SECTION: Vector and Matrix Operations */
+
+/* Public Method that returns the midpoints of the intervals of a vector, see Interval.Midpoint for unbounded ones */
+func (self IntervalVector) Midpoint() []float64 {
+	midpoint := make([]float64, len(self))
+	for i, interval := range self {
+		midpoint[i], _ = interval.Midpoint()
+	}
+	return midpoint
+}
+
+/* Public Method that returns the midpoints of the intervals of a matrix */
+func (self IntervalMatrix) Midpoint() [][]float64 {
+	midpoint := make([][]float64, len(self))
+	for i, row := range self {
+		midpoint[i] = IntervalVector(row).Midpoint()
+	}
+	return midpoint
+}
+
+/* Private Method that returns the number of columns of a matrix, false if its rows differ in length */
+func (self IntervalMatrix) columns() (int, bool) {
+	if len(self) == 0 {
+		return 0, true
+	}
+	for _, row := range self[1:] {
+		if len(row) != len(self[0]) {
+			return 0, false
+		}
+	}
+	return len(self[0]), true
+}
+
+/*
+	Public Method that returns the sum of two vectors, rounded outwards.
+
+	Parameters:
+		other IntervalVector
+	Return:
+		IntervalVector
+		error	ErrDimensionMismatch if the vectors differ in length
+*/
+func (self IntervalVector) Add(other IntervalVector) (IntervalVector, error) {
+	if len(self) != len(other) {
+		return nil, ErrDimensionMismatch
+	}
+	sum := make(IntervalVector, len(self))
+	for i := range self {
+		sum[i] = OutwardAdd(self[i], other[i])
+	}
+	return sum, nil
+}
+
+/*
+	Public Method that returns the difference of two vectors, rounded outwards.
+
+	Parameters:
+		other IntervalVector
+	Return:
+		IntervalVector
+		error	ErrDimensionMismatch if the vectors differ in length
+*/
+func (self IntervalVector) Sub(other IntervalVector) (IntervalVector, error) {
+	if len(self) != len(other) {
+		return nil, ErrDimensionMismatch
+	}
+	difference := make(IntervalVector, len(self))
+	for i := range self {
+		difference[i] = OutwardSub(self[i], other[i])
+	}
+	return difference, nil
+}
+
+/* Private function that returns the dot product of two vectors of the same length, rounded outwards */
+func dot(a, b IntervalVector) Interval[float64] {
+	sum := GenerateClosedInterval(0.0, 0.0)
+	for i := range a {
+		sum = OutwardAdd(sum, OutwardMul(a[i], b[i]))
+	}
+	return sum
+}
+
+/*
+	Public Method that returns the product of a matrix and a vector, rounded outwards: it encloses every A x
+	for A in the matrix and x in the vector.
+
+	Parameters:
+		vector IntervalVector
+	Return:
+		IntervalVector
+		error	ErrDimensionMismatch if the rows of the matrix differ from the vector in length
+*/
+func (self IntervalMatrix) MulVec(vector IntervalVector) (IntervalVector, error) {
+	if columns, ok := self.columns(); !ok || (len(self) > 0 && columns != len(vector)) {
+		return nil, ErrDimensionMismatch
+	}
+	product := make(IntervalVector, len(self))
+	for i, row := range self {
+		product[i] = dot(row, vector)
+	}
+	return product, nil
+}
+
+/*
+	Public Method that returns the product of two matrices, rounded outwards.
+
+	Parameters:
+		other IntervalMatrix
+	Return:
+		IntervalMatrix
+		error	ErrDimensionMismatch if the columns of the matrix differ from the rows of other in number
+*/
+func (self IntervalMatrix) Mul(other IntervalMatrix) (IntervalMatrix, error) {
+	columns, ok := self.columns()
+	otherColumns, otherOk := other.columns()
+	if !ok || !otherOk || (len(self) > 0 && columns != len(other)) {
+		return nil, ErrDimensionMismatch
+	}
+	product := make(IntervalMatrix, len(self))
+	for i, row := range self {
+		product[i] = make([]Interval[float64], otherColumns)
+		for j := range product[i] {
+			column := make(IntervalVector, len(other))
+			for k := range other {
+				column[k] = other[k][j]
+			}
+			product[i][j] = dot(row, column)
+		}
+	}
+	return product, nil
+}
+
+/* !SECTION: Vector and Matrix Operations */
+
+/* SECTION: Linear Systems */
+
+/* Private function that returns a / b for a divisor b that does not hold 0, rounded outwards */
+func divide(a, b Interval[float64]) Interval[float64] {
+	return Span(OutwardDiv(a, b).Intervals()...)
+}
+
+/* Private function that checks that a is a square matrix of bounded intervals and b a vector of its size */
+func validateSystem(a IntervalMatrix, b IntervalVector) error {
+	if columns, ok := a.columns(); !ok || columns != len(a) || len(b) != len(a) {
+		return ErrDimensionMismatch
+	}
+	for _, row := range append([][]Interval[float64]{b}, a...) {
+		for _, entry := range row {
+			switch {
+			case entry.Type == EmptyInterval:
+				return ErrEmptyInterval
+			case entry.LowerBound.IsInfinite() || entry.UpperBound.IsInfinite():
+				return ErrUnboundedInterval
+			}
+		}
+	}
+	return nil
+}
+
+/*
+	Public Function that encloses the solution set of the interval linear system A x = b, every x with A' x = b'
+	for some A' in A and b' in b, by Gaussian elimination on the intervals with outward rounding. The pivot of
+	each column is the entry of largest mignitude, the one furthest from 0.
+
+	Neither method encloses more tightly in general. Gauss does on wide systems, ex: the Barth–Nuding system,
+	whose hull it finds exactly where Krawczyk overestimates it. Krawczyk does on narrow systems close to a point
+	matrix, where the intervals Gauss eliminates with grow faster than the contraction of Krawczyk.
+
+	Parameters:
+		a IntervalMatrix	square matrix of bounded intervals
+		b IntervalVector	bounded intervals, as many as the rows of a
+	Return:
+		IntervalVector
+		error	ErrDimensionMismatch, ErrEmptyInterval, ErrUnboundedInterval or ErrSingularMatrix if a pivot holds 0
+*/
+func Gauss(a IntervalMatrix, b IntervalVector) (IntervalVector, error) {
+	if err := validateSystem(a, b); err != nil {
+		return nil, err
+	}
+	n := len(a)
+	rows := make(IntervalMatrix, n)
+	for i := range a {
+		rows[i] = append(append([]Interval[float64]{}, a[i]...), b[i])
+	}
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if rows[i][k].Mignitude() > rows[pivot][k].Mignitude() {
+				pivot = i
+			}
+		}
+		if rows[pivot][k].Contains(0) {
+			return nil, ErrSingularMatrix
+		}
+		rows[k], rows[pivot] = rows[pivot], rows[k]
+		for i := k + 1; i < n; i++ {
+			factor := divide(rows[i][k], rows[k][k])
+			for j := k + 1; j <= n; j++ {
+				rows[i][j] = OutwardSub(rows[i][j], OutwardMul(factor, rows[k][j]))
+			}
+		}
+	}
+	solution := make(IntervalVector, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := rows[i][n]
+		for j := i + 1; j < n; j++ {
+			sum = OutwardSub(sum, OutwardMul(rows[i][j], solution[j]))
+		}
+		solution[i] = divide(sum, rows[i][i])
+	}
+	return solution, nil
+}
+
+/* Private function that returns the inverse of a square float matrix by Gauss-Jordan elimination, false if singular */
+func inverse(matrix [][]float64) ([][]float64, bool) {
+	n := len(matrix)
+	rows := make([][]float64, n)
+	for i := range matrix {
+		rows[i] = make([]float64, 2*n)
+		copy(rows[i], matrix[i])
+		rows[i][n+i] = 1
+	}
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(rows[i][k]) > math.Abs(rows[pivot][k]) {
+				pivot = i
+			}
+		}
+		if rows[pivot][k] == 0 {
+			return nil, false
+		}
+		rows[k], rows[pivot] = rows[pivot], rows[k]
+		for j := 2*n - 1; j >= k; j-- {
+			rows[k][j] /= rows[k][k]
+		}
+		for i := range rows {
+			if i == k {
+				continue
+			}
+			for j := 2*n - 1; j >= k; j-- {
+				rows[i][j] -= rows[i][k] * rows[k][j]
+			}
+		}
+	}
+	for i := range rows {
+		rows[i] = rows[i][n:]
+		/* a nearly singular matrix has an inverse beyond the range of float64 */
+		for _, Value := range rows[i] {
+			if math.IsInf(Value, 0) || math.IsNaN(Value) {
+				return nil, false
+			}
+		}
+	}
+	return rows, true
+}
+
+/* Private function that returns the largest magnitude of the intervals of a vector (‖·‖∞) */
+func vectorNorm(vector IntervalVector) float64 {
+	norm := 0.0
+	for _, entry := range vector {
+		norm = math.Max(norm, entry.Magnitude())
+	}
+	return norm
+}
+
+/* Private function that returns an upper bound of the largest sum of the magnitudes of a row of a matrix (‖·‖∞) */
+func upperNorm(rows [][]Interval[float64]) float64 {
+	norm := 0.0
+	for _, row := range rows {
+		sum := GenerateClosedInterval(0.0, 0.0)
+		for _, entry := range row {
+			magnitude := entry.Magnitude()
+			sum = OutwardAdd(sum, GenerateClosedInterval(magnitude, magnitude))
+		}
+		if sum.UpperBound.IsInfinite() {
+			return math.Inf(1)
+		}
+		norm = math.Max(norm, sum.UpperBound.Value)
+	}
+	return norm
+}
+
+/*
+	Public Function that encloses the solution set of the interval linear system A x = b, every x with A' x = b'
+	for some A' in A and b' in b, with the Krawczyk operator
+
+		K(X) = x̃ + C (b - A x̃) + (I - C A)(X - x̃)
+
+	where C approximates the inverse of the midpoint of A and x̃ = C mid(b). K(X) holds every solution within X.
+	‖I - C A‖ < 1 proves that every matrix in A is regular; the solutions then lie within x̃ ± ‖C (b - A x̃)‖ /
+	(1 - ‖I - C A‖), an enclosure narrowed by intersecting it with K of itself up to maxKrawczykSteps times.
+	Every norm is the maximum norm ‖·‖∞. If x̃ or that radius exceed the range of float64, the enclosure is
+	(-∞,+∞) in every dimension.
+
+	Parameters:
+		a IntervalMatrix	square matrix of bounded intervals
+		b IntervalVector	bounded intervals, as many as the rows of a
+	Return:
+		IntervalVector
+		error	ErrDimensionMismatch, ErrEmptyInterval, ErrUnboundedInterval or ErrSingularMatrix if the regularity
+				of A cannot be proven
+*/
+func Krawczyk(a IntervalMatrix, b IntervalVector) (IntervalVector, error) {
+	if err := validateSystem(a, b); err != nil {
+		return nil, err
+	}
+	n := len(a)
+	approximate, ok := inverse(a.Midpoint())
+	if !ok {
+		return nil, ErrSingularMatrix
+	}
+	c := NewPointMatrix(approximate)
+	center := make([]float64, n)
+	for i, row := range approximate {
+		for j, Value := range b.Midpoint() {
+			center[i] += row[j] * Value
+		}
+		if math.IsInf(center[i], 0) || math.IsNaN(center[i]) {
+			return unboundedVector(n), nil
+		}
+	}
+	x := NewPointVector(center...)
+
+	/* the dimensions are validated, so the operations below cannot fail */
+	ax, _ := a.MulVec(x)
+	residual, _ := b.Sub(ax)
+	correction, _ := c.MulVec(residual)
+	ca, _ := c.Mul(a)
+	contraction := NewIdentityMatrix(n)
+	for i := range contraction {
+		for j := range contraction[i] {
+			contraction[i][j] = OutwardSub(contraction[i][j], ca[i][j])
+		}
+	}
+
+	norm := upperNorm(contraction)
+	if !(norm < 1) {
+		return nil, ErrSingularMatrix
+	}
+	/* the radius ‖correction‖ / (1 - ‖contraction‖), rounded up */
+	size := vectorNorm(correction)
+	if math.IsInf(size, 1) {
+		return unboundedVector(n), nil
+	}
+	slack := OutwardSub(GenerateClosedInterval(1.0, 1.0), GenerateClosedInterval(norm, norm))
+	bound := divide(GenerateClosedInterval(size, size), slack).UpperBound
+	if bound.IsInfinite() {
+		return unboundedVector(n), nil
+	}
+	radius := bound.Value
+
+	enclosure := make(IntervalVector, n)
+	for i := range enclosure {
+		enclosure[i] = OutwardAdd(x[i], GenerateClosedInterval(-radius, radius))
+	}
+	for step := 0; step < maxKrawczykSteps; step++ {
+		offset, _ := enclosure.Sub(x)
+		spread, _ := contraction.MulVec(offset)
+		next, _ := x.Add(correction)
+		next, _ = next.Add(spread)
+		narrowed := false
+		for i := range next {
+			part := Intersect(enclosure[i], next[i])
+			if part.Type == EmptyInterval || Equal(part, enclosure[i]) {
+				continue
+			}
+			enclosure[i], narrowed = part, true
+		}
+		if !narrowed {
+			break
+		}
+	}
+	return enclosure, nil
+}
+
+/* Private function that returns the vector of n intervals (-∞,+∞) */
+func unboundedVector(n int) IntervalVector {
+	vector := make(IntervalVector, n)
+	for i := range vector {
+		vector[i] = GenerateUnboundedInterval[float64]()
+	}
+	return vector
+}
+
+/* !SECTION: Linear Systems */
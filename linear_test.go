@@ -0,0 +1,242 @@
+package interval
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+/* SECTION: Linear Algebra Testing */
+
+func TestMulVec(t *testing.T) {
+	a := IntervalMatrix{
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(-1.0, 0.0)},
+		{GenerateClosedInterval(0.0, 0.0), GenerateOpenInterval(3.0, 4.0)},
+	}
+	product, err := a.MulVec(NewPointVector(2, 1))
+	AssertTrue(err == nil, t)
+	AssertEqual(product[0].String(), "[1,4]", t)
+	AssertEqual(product[1].String(), "(3,4)", t)
+
+	_, err = a.MulVec(NewPointVector(1, 2, 3))
+	AssertTrue(errors.Is(err, ErrDimensionMismatch), t)
+	_, err = IntervalMatrix{{singleton(1)}, {singleton(1), singleton(2)}}.MulVec(NewPointVector(1))
+	AssertTrue(errors.Is(err, ErrDimensionMismatch), t)
+}
+
+func TestMatrixMul(t *testing.T) {
+	a := NewPointMatrix([][]float64{{1, 2}, {3, 4}})
+	product, err := a.Mul(NewIdentityMatrix(2))
+	AssertTrue(err == nil, t)
+	for i := range a {
+		for j := range a[i] {
+			AssertTrue(Equal(product[i][j], a[i][j]), t)
+		}
+	}
+	product, err = a.Mul(IntervalMatrix{{GenerateClosedInterval(-1.0, 1.0)}, {singleton(1)}})
+	AssertTrue(err == nil, t)
+	AssertEqual(product[0][0].String(), "[1,3]", t)
+	AssertEqual(product[1][0].String(), "[1,7]", t)
+
+	_, err = a.Mul(NewIdentityMatrix(3))
+	AssertTrue(errors.Is(err, ErrDimensionMismatch), t)
+
+	sum, err := NewPointVector(1, 2).Add(NewPointVector(0.5, 0.25))
+	AssertTrue(err == nil, t)
+	AssertEqual(sum[1].String(), "[2.25,2.25]", t)
+	_, err = sum.Sub(NewPointVector(1))
+	AssertTrue(errors.Is(err, ErrDimensionMismatch), t)
+}
+
+func TestPointSystem(t *testing.T) {
+	/* 2x + y = 3 and x + 3y = 5 have the solution x = 0.8, y = 1.4 */
+	a := NewPointMatrix([][]float64{{2, 1}, {1, 3}})
+	b := NewPointVector(3, 5)
+	for _, solve := range []func(IntervalMatrix, IntervalVector) (IntervalVector, error){Gauss, Krawczyk} {
+		solution, err := solve(a, b)
+		AssertTrue(err == nil, t)
+		AssertTrue(solution[0].Contains(0.8) && solution[0].Length() < 1e-14, t)
+		AssertTrue(solution[1].Contains(1.4) && solution[1].Length() < 1e-14, t)
+	}
+}
+
+func TestIntervalSystemHull(t *testing.T) {
+	/* the hull of the solution set of this system is [-5.708,5.708] × [-4.747,4.747] */
+	a := IntervalMatrix{
+		{GenerateClosedInterval(3.7, 4.3), GenerateClosedInterval(-1.5, -0.5)},
+		{GenerateClosedInterval(-1.5, -0.5), GenerateClosedInterval(3.7, 4.3)},
+	}
+	b := IntervalVector{GenerateClosedInterval(-14.0, 14.0), GenerateClosedInterval(-9.0, 9.0)}
+	for _, solve := range []func(IntervalMatrix, IntervalVector) (IntervalVector, error){Gauss, Krawczyk} {
+		solution, err := solve(a, b)
+		AssertTrue(err == nil, t)
+		AssertTrue(solution[0].ContainsInterval(GenerateClosedInterval(-5.708041958041958, 5.708041958041958)), t)
+		AssertTrue(solution[1].ContainsInterval(GenerateClosedInterval(-4.746503496503497, 4.746503496503497)), t)
+		AssertTrue(solution[0].Length() < 11.417 && solution[1].Length() < 9.494, t)
+	}
+}
+
+func TestGaussAndKrawczykTightness(t *testing.T) {
+	/* the Barth–Nuding system: Gauss finds its hull [-120,90] × [-60,240], Krawczyk is wider in both */
+	a := IntervalMatrix{
+		{GenerateClosedInterval(2.0, 3.0), GenerateClosedInterval(0.0, 1.0)},
+		{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(2.0, 3.0)},
+	}
+	b := IntervalVector{GenerateClosedInterval(0.0, 120.0), GenerateClosedInterval(60.0, 240.0)}
+	gauss, err := Gauss(a, b)
+	AssertTrue(err == nil, t)
+	AssertEqual(gauss[0].String(), "[-120,90]", t)
+	AssertEqual(gauss[1].String(), "[-60,240]", t)
+	krawczyk, err := Krawczyk(a, b)
+	AssertTrue(err == nil, t)
+	for i := range gauss {
+		AssertTrue(krawczyk[i].ContainsInterval(gauss[i]) && krawczyk[i].Length() > gauss[i].Length(), t)
+	}
+
+	/* a narrow system around a point matrix: Krawczyk is narrower in every dimension */
+	points := [][]float64{{4, 1, -1, 0.5}, {1, -5, 2, 1}, {0.5, 1, 6, -2}, {2, -1, 1, 7}}
+	a = make(IntervalMatrix, len(points))
+	for i, row := range points {
+		a[i] = make([]Interval[float64], len(row))
+		for j, Value := range row {
+			a[i][j] = GenerateClosedInterval(Value-1e-3, Value+1e-3)
+		}
+	}
+	b = IntervalVector{
+		GenerateClosedInterval(0.99, 1.01), GenerateClosedInterval(1.99, 2.01),
+		GenerateClosedInterval(-1.01, -0.99), GenerateClosedInterval(2.99, 3.01),
+	}
+	gauss, err = Gauss(a, b)
+	AssertTrue(err == nil, t)
+	krawczyk, err = Krawczyk(a, b)
+	AssertTrue(err == nil, t)
+	for i := range gauss {
+		AssertTrue(krawczyk[i].Length() < gauss[i].Length(), t)
+	}
+}
+
+/* Private helper that returns the exact solution of a regular square system of rationals */
+func solveRat(a [][]*big.Rat, b []*big.Rat) []*big.Rat {
+	n := len(a)
+	for k := 0; k < n; k++ {
+		pivot := k
+		for a[pivot][k].Sign() == 0 {
+			pivot++
+		}
+		a[k], a[pivot], b[k], b[pivot] = a[pivot], a[k], b[pivot], b[k]
+		for i := k + 1; i < n; i++ {
+			factor := new(big.Rat).Quo(a[i][k], a[k][k])
+			for j := k; j < n; j++ {
+				a[i][j] = new(big.Rat).Sub(a[i][j], new(big.Rat).Mul(factor, a[k][j]))
+			}
+			b[i] = new(big.Rat).Sub(b[i], new(big.Rat).Mul(factor, b[k]))
+		}
+	}
+	x := make([]*big.Rat, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := new(big.Rat).Set(b[i])
+		for j := i + 1; j < n; j++ {
+			sum.Sub(sum, new(big.Rat).Mul(a[i][j], x[j]))
+		}
+		x[i] = sum.Quo(sum, a[i][i])
+	}
+	return x
+}
+
+func TestIntervalSystemEncloses(t *testing.T) {
+	a := IntervalMatrix{
+		{GenerateClosedInterval(9.75, 10.25), GenerateClosedInterval(0.875, 1.125), GenerateClosedInterval(-2.0, -1.5)},
+		{GenerateClosedInterval(1.0, 1.0), GenerateClosedInterval(-8.5, -7.5), GenerateClosedInterval(2.0, 2.25)},
+		{GenerateClosedInterval(-0.5, 0.5), GenerateClosedInterval(1.75, 2.0), GenerateClosedInterval(6.0, 6.5)},
+	}
+	b := IntervalVector{GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(-3.0, -2.5), GenerateClosedInterval(0.25, 0.5)}
+	gauss, err := Gauss(a, b)
+	AssertTrue(err == nil, t)
+	krawczyk, err := Krawczyk(a, b)
+	AssertTrue(err == nil, t)
+
+	/* every vertex system, the extremes of the solution set, is solved exactly */
+	endpoint := func(interval Interval[float64], upper bool) *big.Rat {
+		if upper {
+			return exactRat(interval.UpperBound.Value)
+		}
+		return exactRat(interval.LowerBound.Value)
+	}
+	failures := 0
+	for vertex := 0; vertex < 1<<12; vertex++ {
+		bit := 0
+		next := func(interval Interval[float64]) *big.Rat {
+			bit++
+			return endpoint(interval, vertex>>(bit-1)&1 == 1)
+		}
+		matrix := make([][]*big.Rat, 3)
+		vector := make([]*big.Rat, 3)
+		for i := range a {
+			matrix[i] = []*big.Rat{next(a[i][0]), next(a[i][1]), next(a[i][2])}
+			vector[i] = next(b[i])
+		}
+		for i, Value := range solveRat(matrix, vector) {
+			for _, enclosure := range []Interval[float64]{gauss[i], krawczyk[i]} {
+				if endpoint(enclosure, false).Cmp(Value) > 0 || endpoint(enclosure, true).Cmp(Value) < 0 {
+					failures++
+				}
+			}
+		}
+	}
+	AssertEqual(failures, 0, t)
+}
+
+func TestKrawczykOverflow(t *testing.T) {
+	/* the correction ‖[-1e308,1e308]‖ is finite although the sum of its magnitudes is not */
+	huge := GenerateClosedInterval(-1e308, 1e308)
+	solution, err := Krawczyk(NewIdentityMatrix(2), IntervalVector{huge, huge})
+	AssertTrue(err == nil, t)
+	AssertTrue(solution[0].ContainsInterval(huge) && solution[1].ContainsInterval(huge), t)
+
+	/* solutions beyond the range of float64 are enclosed by (-∞,+∞) */
+	tests := []struct {
+		a IntervalMatrix
+		b IntervalVector
+	}{
+		{NewPointMatrix([][]float64{{0.5}}), NewPointVector(1.5e308)},
+		{IntervalMatrix{{GenerateClosedInterval(0.9, 1.1)}}, NewPointVector(1.7e308)},
+	}
+	for _, test := range tests {
+		solution, err = Krawczyk(test.a, test.b)
+		AssertTrue(err == nil, t)
+		AssertEqual(solution[0], GenerateUnboundedInterval[float64](), t)
+	}
+
+	/* the inverse of the midpoint of [1e-310] is beyond the range of float64 */
+	_, err = Krawczyk(NewPointMatrix([][]float64{{1e-310}}), NewPointVector(0))
+	AssertTrue(errors.Is(err, ErrSingularMatrix), t)
+}
+
+func TestSystemErrors(t *testing.T) {
+	singular := NewPointMatrix([][]float64{{1, 2}, {2, 4}})
+	_, err := Gauss(singular, NewPointVector(1, 2))
+	AssertTrue(errors.Is(err, ErrSingularMatrix), t)
+	_, err = Krawczyk(singular, NewPointVector(1, 2))
+	AssertTrue(errors.Is(err, ErrSingularMatrix), t)
+
+	/* [-1,1] holds the singular matrix [0] */
+	wide := IntervalMatrix{{GenerateClosedInterval(-1.0, 1.0)}}
+	_, err = Gauss(wide, NewPointVector(1))
+	AssertTrue(errors.Is(err, ErrSingularMatrix), t)
+	_, err = Krawczyk(wide, NewPointVector(1))
+	AssertTrue(errors.Is(err, ErrSingularMatrix), t)
+	/* [0.5,2] holds no singular matrix and is narrow enough for Krawczyk to contract */
+	_, err = Krawczyk(IntervalMatrix{{GenerateClosedInterval(0.5, 2.0)}}, NewPointVector(1))
+	AssertTrue(err == nil, t)
+
+	_, err = Gauss(NewPointMatrix([][]float64{{1, 2}}), NewPointVector(1))
+	AssertTrue(errors.Is(err, ErrDimensionMismatch), t)
+	_, err = Krawczyk(NewIdentityMatrix(2), NewPointVector(1))
+	AssertTrue(errors.Is(err, ErrDimensionMismatch), t)
+	_, err = Gauss(IntervalMatrix{{GenerateAtLeastInterval(1.0)}}, NewPointVector(1))
+	AssertTrue(errors.Is(err, ErrUnboundedInterval), t)
+	_, err = Krawczyk(NewIdentityMatrix(1), IntervalVector{GenerateEmptyInterval[float64]()})
+	AssertTrue(errors.Is(err, ErrEmptyInterval), t)
+}
+
+/* !SECTION: Linear Algebra Testing */
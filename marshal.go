@@ -0,0 +1,318 @@
+package interval
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+/* SECTION: PointType Marshaling */
+
+/* Public Method that returns the name of the PointType used in text and JSON forms. */
+func (self PointType) String() string {
+	switch self {
+	case OpenPoint:
+		return "open"
+	case ClosedPoint:
+		return "closed"
+	case UnboundedPoint:
+		return "unbounded"
+	case NegativeUnboundedPoint:
+		return "-inf"
+	case PositiveUnboundedPoint:
+		return "+inf"
+	}
+	return fmt.Sprintf("PointType(%d)", int(self))
+}
+
+func (self PointType) MarshalText() ([]byte, error) {
+	switch self {
+	case OpenPoint, ClosedPoint, UnboundedPoint, NegativeUnboundedPoint, PositiveUnboundedPoint:
+		return []byte(self.String()), nil
+	}
+	return nil, fmt.Errorf("cannot marshal unknown %v", self)
+}
+
+func (self *PointType) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "open":
+		*self = OpenPoint
+	case "closed":
+		*self = ClosedPoint
+	case "unbounded", "∞":
+		*self = UnboundedPoint
+	case "-inf", "-∞":
+		*self = NegativeUnboundedPoint
+	case "+inf", "+∞":
+		*self = PositiveUnboundedPoint
+	default:
+		return fmt.Errorf("unknown PointType %q", text)
+	}
+	return nil
+}
+
+/* !SECTION: PointType Marshaling */
+
+/* SECTION: Point Marshaling */
+
+/* Public Method that returns the notation of a Point: [a] when Closed, (a) when Open, -∞, +∞ or ∞ when Unbounded. */
+func (self Point[N]) String() string {
+	switch self.Type {
+	case OpenPoint:
+		return fmt.Sprintf("(%v)", self.Value)
+	case ClosedPoint:
+		return fmt.Sprintf("[%v]", self.Value)
+	case UnboundedPoint:
+		return "∞"
+	case NegativeUnboundedPoint:
+		return "-∞"
+	case PositiveUnboundedPoint:
+		return "+∞"
+	}
+	return fmt.Sprintf("%v(%v)", self.Type, self.Value)
+}
+
+/*
+	Public Function that parses the notation produced by Point.String(). Infinities may also be written
+	-inf and +inf.
+
+	Parameters:
+		notation string
+	Return:
+		Point[N]
+		error	*ParseError
+*/
+func ParsePoint[N Numeric](notation string) (Point[N], error) {
+	self := &parser{input: notation}
+	self.skipSpace()
+	if infinity, ok := self.consume("-∞", "-inf", "+∞", "+inf", "∞"); ok {
+		if err := self.expectEnd(); err != nil {
+			return Point[N]{}, err
+		}
+		var point Point[N]
+		point.UnmarshalText([]byte(infinity))
+		return point, nil
+	}
+	opening, err := self.expect("[", "(")
+	if err != nil {
+		return Point[N]{}, err
+	}
+	Value, infinity, offset, err := parseEndpoint[N](self, ")]")
+	if err != nil {
+		return Point[N]{}, err
+	}
+	if infinity != 0 {
+		return Point[N]{}, self.errorAt(offset, nil, "an infinity is written without brackets")
+	}
+	closing := map[string]string{"[": "]", "(": ")"}[opening]
+	if _, err := self.expect(closing); err != nil {
+		return Point[N]{}, err
+	}
+	if err := self.expectEnd(); err != nil {
+		return Point[N]{}, err
+	}
+	if opening == "[" {
+		return Point[N]{Value: Value, Type: ClosedPoint}, nil
+	}
+	return Point[N]{Value: Value, Type: OpenPoint}, nil
+}
+
+func (self Point[N]) MarshalText() ([]byte, error) {
+	if _, err := self.Type.MarshalText(); err != nil {
+		return nil, err
+	}
+	return []byte(self.String()), nil
+}
+
+func (self *Point[N]) UnmarshalText(text []byte) error {
+	var pointType PointType
+	if pointType.UnmarshalText(text) == nil && pointType != OpenPoint && pointType != ClosedPoint {
+		*self = Point[N]{Type: pointType}
+		return nil
+	}
+	point, err := ParsePoint[N](string(text))
+	if err != nil {
+		return err
+	}
+	*self = point
+	return nil
+}
+
+/* Private structured JSON form of a Point. Value is omitted for infinities. */
+type structuredPoint[N Numeric] struct {
+	Value *N        `json:"value,omitempty"`
+	Type  PointType `json:"type"`
+}
+
+/* Private function that converts a Point to its structured JSON form. */
+func toStructuredPoint[N Numeric](point Point[N]) structuredPoint[N] {
+	structured := structuredPoint[N]{Type: point.Type}
+	if !point.IsInfinite() {
+		Value := point.Value
+		structured.Value = &Value
+	}
+	return structured
+}
+
+/* Private method that converts the structured JSON form back into a Point. */
+func (self structuredPoint[N]) point(side string) (Point[N], error) {
+	if self.Type.IsFinite() {
+		if self.Value == nil {
+			return Point[N]{}, fmt.Errorf("%s Point of type %v needs a value", side, self.Type)
+		}
+		return Point[N]{Value: *self.Value, Type: self.Type}, nil
+	}
+	return Point[N]{Type: self.Type}, nil
+}
+
+/* Public Method that encodes the Point as its compact notation, ex: "[3]" or "-∞". See StructuredPoint. */
+func (self Point[N]) MarshalJSON() ([]byte, error) {
+	text, err := self.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+/* Public Method that decodes either the compact notation or the structured {"value":3,"type":"closed"} form. */
+func (self *Point[N]) UnmarshalJSON(data []byte) error {
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return self.UnmarshalText([]byte(text))
+	}
+	var structured structuredPoint[N]
+	if err := json.Unmarshal(data, &structured); err != nil {
+		return err
+	}
+	point, err := structured.point("the")
+	if err != nil {
+		return err
+	}
+	*self = point
+	return nil
+}
+
+/* StructuredPoint Type to encode a Point in the structured JSON form {"value":3,"type":"closed"}. */
+type StructuredPoint[N Numeric] Point[N]
+
+func (self StructuredPoint[N]) MarshalJSON() ([]byte, error) {
+	if _, err := self.Type.MarshalText(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(toStructuredPoint(Point[N](self)))
+}
+
+func (self *StructuredPoint[N]) UnmarshalJSON(data []byte) error {
+	return (*Point[N])(self).UnmarshalJSON(data)
+}
+
+/* !SECTION: Point Marshaling */
+
+/* SECTION: Interval Marshaling */
+
+func (self Interval[N]) MarshalText() ([]byte, error) {
+	return []byte(self.String()), nil
+}
+
+func (self *Interval[N]) UnmarshalText(text []byte) error {
+	interval, err := ParseInterval[N](string(text))
+	if err != nil {
+		return err
+	}
+	*self = interval
+	return nil
+}
+
+/*
+	Private structured JSON form of an Interval. Infinite bounds omit their Value, and an Empty Interval
+	is written {"empty":true}.
+*/
+type structuredInterval[N Numeric] struct {
+	Empty     bool       `json:"empty,omitempty"`
+	Lower     *N         `json:"lower,omitempty"`
+	Upper     *N         `json:"upper,omitempty"`
+	LowerType *PointType `json:"lowerType,omitempty"`
+	UpperType *PointType `json:"upperType,omitempty"`
+}
+
+/* Public Method that encodes the Interval as its compact Interval Notation, ex: "[1,5)". See StructuredInterval. */
+func (self Interval[N]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(self.String())
+}
+
+/*
+	Public Method that decodes either the compact Interval Notation or the structured form
+	{"lower":1,"upper":5,"lowerType":"closed","upperType":"open"}. In the structured form a missing
+	type defaults to closed when the Value is present and to the infinity of its side otherwise.
+*/
+func (self *Interval[N]) UnmarshalJSON(data []byte) error {
+	if data = bytes.TrimSpace(data); len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		return self.UnmarshalText([]byte(text))
+	}
+	var structured structuredInterval[N]
+	if err := json.Unmarshal(data, &structured); err != nil {
+		return err
+	}
+	if structured.Empty {
+		*self = GenerateEmptyInterval[N]()
+		return nil
+	}
+	lower := structuredPoint[N]{Value: structured.Lower, Type: NegativeUnboundedPoint}
+	if structured.LowerType != nil {
+		lower.Type = *structured.LowerType
+	} else if structured.Lower != nil {
+		lower.Type = ClosedPoint
+	}
+	upper := structuredPoint[N]{Value: structured.Upper, Type: PositiveUnboundedPoint}
+	if structured.UpperType != nil {
+		upper.Type = *structured.UpperType
+	} else if structured.Upper != nil {
+		upper.Type = ClosedPoint
+	}
+	start, err := lower.point("lower")
+	if err != nil {
+		return err
+	}
+	end, err := upper.point("upper")
+	if err != nil {
+		return err
+	}
+	interval, err := NewInterval(start, end)
+	if err != nil {
+		return err
+	}
+	*self = interval
+	return nil
+}
+
+/*
+	StructuredInterval Type to encode an Interval in the structured JSON form
+	{"lower":1,"upper":5,"lowerType":"closed","upperType":"open"}, ex: as a field of a configuration struct.
+*/
+type StructuredInterval[N Numeric] Interval[N]
+
+func (self StructuredInterval[N]) MarshalJSON() ([]byte, error) {
+	if self.Type == EmptyInterval {
+		return json.Marshal(structuredInterval[N]{Empty: true})
+	}
+	lower, upper := toStructuredPoint(self.LowerBound), toStructuredPoint(self.UpperBound)
+	return json.Marshal(structuredInterval[N]{
+		Lower:     lower.Value,
+		Upper:     upper.Value,
+		LowerType: &lower.Type,
+		UpperType: &upper.Type,
+	})
+}
+
+func (self *StructuredInterval[N]) UnmarshalJSON(data []byte) error {
+	return (*Interval[N])(self).UnmarshalJSON(data)
+}
+
+/* !SECTION: Interval Marshaling */
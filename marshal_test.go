@@ -0,0 +1,189 @@
+package interval
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+/* SECTION: Marshal Testing */
+
+func TestIntervalTextRoundTrip(t *testing.T) {
+	integers, floats := roundTripIntervals()
+	for _, interval := range integers {
+		text, err := interval.MarshalText()
+		AssertTrue(err == nil, t)
+		var decoded Interval[int]
+		AssertTrue(decoded.UnmarshalText(text) == nil, t)
+		assertSameInterval(decoded, interval, t)
+	}
+	for _, interval := range floats {
+		text, err := interval.MarshalText()
+		AssertTrue(err == nil, t)
+		var decoded Interval[float64]
+		AssertTrue(decoded.UnmarshalText(text) == nil, t)
+		assertSameInterval(decoded, interval, t)
+	}
+}
+
+func TestIntervalJSONRoundTrip(t *testing.T) {
+	integers, floats := roundTripIntervals()
+	for _, interval := range integers {
+		for _, encode := range []interface{}{interval, StructuredInterval[int](interval)} {
+			data, err := json.Marshal(encode)
+			AssertTrue(err == nil, t)
+			var decoded Interval[int]
+			AssertTrue(json.Unmarshal(data, &decoded) == nil, t)
+			assertSameInterval(decoded, interval, t)
+		}
+	}
+	for _, interval := range floats {
+		for _, encode := range []interface{}{interval, StructuredInterval[float64](interval)} {
+			data, err := json.Marshal(encode)
+			AssertTrue(err == nil, t)
+			var decoded Interval[float64]
+			AssertTrue(json.Unmarshal(data, &decoded) == nil, t)
+			assertSameInterval(decoded, interval, t)
+		}
+	}
+}
+
+func TestIntervalJSONForms(t *testing.T) {
+	tests := []struct {
+		interval   Interval[int]
+		compact    string
+		structured string
+	}{
+		{GenerateClosedOpenInterval(1, 5), `"[1,5)"`, `{"lower":1,"upper":5,"lowerType":"closed","upperType":"open"}`},
+		{GenerateGreaterThanInterval(2), `"(2,+∞)"`, `{"lower":2,"lowerType":"open","upperType":"+inf"}`},
+		{GenerateUnboundedInterval[int](), `"(-∞,+∞)"`, `{"lowerType":"-inf","upperType":"+inf"}`},
+		{GenerateEmptyInterval[int](), `"{}"`, `{"empty":true}`},
+	}
+	for _, test := range tests {
+		compact, err := json.Marshal(test.interval)
+		AssertTrue(err == nil, t)
+		AssertEqual(string(compact), test.compact, t)
+
+		structured, err := json.Marshal(StructuredInterval[int](test.interval))
+		AssertTrue(err == nil, t)
+		AssertEqual(string(structured), test.structured, t)
+	}
+}
+
+func TestIntervalJSONField(t *testing.T) {
+	type config struct {
+		Range  Interval[int]           `json:"range"`
+		Window StructuredInterval[int] `json:"window"`
+	}
+	value := config{
+		Range:  GenerateOpenClosedInterval(0, 10),
+		Window: StructuredInterval[int](GenerateAtMostInterval(3)),
+	}
+	data, err := json.Marshal(value)
+	AssertTrue(err == nil, t)
+	AssertEqual(string(data), `{"range":"(0,10]","window":{"upper":3,"lowerType":"-inf","upperType":"closed"}}`, t)
+
+	var decoded config
+	AssertTrue(json.Unmarshal(data, &decoded) == nil, t)
+	assertSameInterval(decoded.Range, value.Range, t)
+	assertSameInterval(Interval[int](decoded.Window), Interval[int](value.Window), t)
+}
+
+func TestIntervalJSONDefaults(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected string
+	}{
+		{`{"lower":1,"upper":5}`, "[1,5]"},
+		{`{"lower":1}`, "[1,+∞)"},
+		{`{"upper":5,"upperType":"open"}`, "(-∞,5)"},
+		{`{}`, "(-∞,+∞)"},
+		{`{"lowerType":"unbounded","upperType":"unbounded"}`, "(-∞,+∞)"},
+		{`{"lower":2,"upper":2,"lowerType":"open","upperType":"closed"}`, "{}"},
+	}
+	for _, test := range tests {
+		var decoded Interval[int]
+		AssertTrue(json.Unmarshal([]byte(test.data), &decoded) == nil, t)
+		AssertEqual(decoded.String(), test.expected, t)
+	}
+}
+
+func TestIntervalJSONErrors(t *testing.T) {
+	var decoded Interval[int]
+	err := json.Unmarshal([]byte(`{"lower":5,"upper":1}`), &decoded)
+	AssertTrue(errors.Is(err, ErrInvertedBounds), t)
+
+	err = json.Unmarshal([]byte(`{"lowerType":"+inf","upper":1}`), &decoded)
+	AssertTrue(errors.Is(err, ErrMisplacedInfinity), t)
+
+	err = json.Unmarshal([]byte(`{"lowerType":"open","upper":1}`), &decoded)
+	AssertTrue(err != nil, t)
+
+	err = json.Unmarshal([]byte(`{"lowerType":"half","upper":1}`), &decoded)
+	AssertTrue(err != nil, t)
+
+	err = json.Unmarshal([]byte(`{"lower":1.5,"upper":3}`), &decoded)
+	AssertTrue(err != nil, t)
+
+	err = json.Unmarshal([]byte(`"[1,5"`), &decoded)
+	var parseErr *ParseError
+	AssertTrue(errors.As(err, &parseErr), t)
+}
+
+func TestPointMarshal(t *testing.T) {
+	tests := []struct {
+		point      Point[float64]
+		compact    string
+		structured string
+	}{
+		{Point[float64]{Value: 3, Type: ClosedPoint}, `"[3]"`, `{"value":3,"type":"closed"}`},
+		{Point[float64]{Value: -1.5, Type: OpenPoint}, `"(-1.5)"`, `{"value":-1.5,"type":"open"}`},
+		{NegativeInfinity[float64](), `"-∞"`, `{"type":"-inf"}`},
+		{PositiveInfinity[float64](), `"+∞"`, `{"type":"+inf"}`},
+	}
+	for _, test := range tests {
+		compact, err := json.Marshal(test.point)
+		AssertTrue(err == nil, t)
+		AssertEqual(string(compact), test.compact, t)
+
+		structured, err := json.Marshal(StructuredPoint[float64](test.point))
+		AssertTrue(err == nil, t)
+		AssertEqual(string(structured), test.structured, t)
+
+		for _, data := range []string{test.compact, test.structured} {
+			var decoded Point[float64]
+			AssertTrue(json.Unmarshal([]byte(data), &decoded) == nil, t)
+			AssertEqual(decoded, test.point, t)
+		}
+	}
+}
+
+func TestParsePoint(t *testing.T) {
+	point, err := ParsePoint[int](" [ 4 ] ")
+	AssertTrue(err == nil, t)
+	AssertEqual(point, Point[int]{Value: 4, Type: ClosedPoint}, t)
+
+	point, err = ParsePoint[int]("-inf")
+	AssertTrue(err == nil, t)
+	AssertEqual(point, NegativeInfinity[int](), t)
+
+	for _, notation := range []string{"4", "[4)", "(4]", "[+∞]", "[4] x", ""} {
+		_, err := ParsePoint[int](notation)
+		var parseErr *ParseError
+		AssertTrue(errors.As(err, &parseErr), t)
+	}
+}
+
+func TestPointTypeText(t *testing.T) {
+	for _, pointType := range []PointType{OpenPoint, ClosedPoint, UnboundedPoint, NegativeUnboundedPoint, PositiveUnboundedPoint} {
+		text, err := pointType.MarshalText()
+		AssertTrue(err == nil, t)
+		var decoded PointType
+		AssertTrue(decoded.UnmarshalText(text) == nil, t)
+		AssertEqual(decoded, pointType, t)
+	}
+	_, err := PointType(42).MarshalText()
+	AssertTrue(err != nil, t)
+}
+
+/* !SECTION: Marshal Testing */
@@ -0,0 +1,207 @@
+package interval
+
+import (
+	"math"
+	"strconv"
+)
+
+type CardinalityKind int // Cardinality Kind Enum Type
+
+const (
+	FiniteCardinality    CardinalityKind = iota // a finite amount of Values, see Cardinality.Size
+	CountableCardinality                        // as many Values as the integers, ex: the integers of [0,+∞)
+	ContinuumCardinality                        // as many Values as the real numbers, ex: the reals of [0,1]
+)
+
+/*
+	Cardinality Type to represent the number of Values of an interval, including infinite numbers that an int
+	cannot hold.
+
+	Size is only meaningful for a FiniteCardinality. It saturates at math.MaxUint64, which only happens for
+	the intervals holding all 2^64 Values of int64 or uint64.
+*/
+type Cardinality struct {
+	Kind CardinalityKind
+	Size uint64
+}
+
+/* Public Boolean Method that returns true if the Cardinality is finite. */
+func (self Cardinality) IsFinite() bool {
+	return self.Kind == FiniteCardinality
+}
+
+/* Public Method that returns the Cardinality as a number or as ℵ₀ (countable) or 𝔠 (continuum) */
+func (self Cardinality) String() string {
+	switch self.Kind {
+	case CountableCardinality:
+		return "ℵ₀"
+	case ContinuumCardinality:
+		return "𝔠"
+	}
+	return strconv.FormatUint(self.Size, 10)
+}
+
+/*
+	Public Method that returns the Cardinality of an interval.
+
+	Integer intervals are sets of integers: bounded ones are finite and unbounded ones are countably infinite
+	(as sets of integers, not of the Values N can hold). Float intervals are sets of real numbers: they are
+	uncountable unless they are Empty or Degenerate.
+
+	Return:
+		Cardinality
+*/
+func (self Interval[N]) Cardinality() Cardinality {
+	switch self.Type {
+	case EmptyInterval:
+		return Cardinality{Kind: FiniteCardinality, Size: 0}
+	case DegenerateInterval:
+		return Cardinality{Kind: FiniteCardinality, Size: 1}
+	}
+	if isFloat[N]() {
+		return Cardinality{Kind: ContinuumCardinality}
+	}
+	if self.LowerBound.IsInfinite() || self.UpperBound.IsInfinite() {
+		return Cardinality{Kind: CountableCardinality}
+	}
+	return Cardinality{Kind: FiniteCardinality, Size: self.size()}
+}
+
+/* Private Method that returns the number of integer Values of a bounded interval, saturating at math.MaxUint64 */
+func (self Interval[N]) size() uint64 {
+	first, last, ok := self.integerRange()
+	if !ok {
+		return 0
+	}
+	var difference uint64
+	switch {
+	case isFloat[N]():
+		if float64(last-first) >= math.MaxUint64 {
+			return math.MaxUint64
+		}
+		difference = uint64(last - first)
+	case isUnsigned[N]():
+		difference = uint64(last) - uint64(first)
+	default:
+		/* the difference of two int64 always fits in a uint64 */
+		difference = uint64(int64(last)) - uint64(int64(first))
+	}
+	if difference == math.MaxUint64 {
+		return difference
+	}
+	return difference + 1
+}
+
+/*
+	Public Method that returns the length of an interval, i.e. the distance between its endpoints.
+	Unbounded intervals have an infinite length and the Empty Interval has a length of 0.
+
+	Return:
+		float64
+*/
+func (self Interval[N]) Length() float64 {
+	if self.Type == EmptyInterval {
+		return 0
+	}
+	if self.LowerBound.IsInfinite() || self.UpperBound.IsInfinite() {
+		return math.Inf(1)
+	}
+	return float64(self.UpperBound.Value) - float64(self.LowerBound.Value)
+}
+
+/*
+	Public Method that returns the (Lebesgue) measure of an interval. For a single interval it is its Length:
+	Open and Closed endpoints do not change the measure.
+
+	Return:
+		float64
+*/
+func (self Interval[N]) Measure() float64 {
+	return self.Length()
+}
+
+/*
+	Public Method that returns the midpoint of an interval.
+
+	Only bounded intervals have a midpoint. Half-lines return an infinite Value on their unbounded side,
+	(-∞,+∞) and the Empty Interval return NaN, and all of them return false.
+
+	Return:
+		float64
+		bool	true if the interval is bounded and not Empty
+*/
+func (self Interval[N]) Midpoint() (float64, bool) {
+	if self.Type == EmptyInterval {
+		return math.NaN(), false
+	}
+	lower, upper := self.LowerBound.IsInfinite(), self.UpperBound.IsInfinite()
+	switch {
+	case lower && upper:
+		return math.NaN(), false
+	case lower:
+		return math.Inf(-1), false
+	case upper:
+		return math.Inf(1), false
+	}
+	/* halve before adding so that the sum cannot overflow */
+	return float64(self.LowerBound.Value)/2 + float64(self.UpperBound.Value)/2, true
+}
+
+/*
+	Public Method that returns the radius of an interval: half its Length.
+	Unbounded intervals have an infinite radius and the Empty Interval returns NaN.
+
+	Return:
+		float64
+*/
+func (self Interval[N]) Radius() float64 {
+	if self.Type == EmptyInterval {
+		return math.NaN()
+	}
+	return self.Length() / 2
+}
+
+/*
+	Public Method that returns the magnitude of an interval: the largest absolute Value it approaches,
+	max(|a|, |b|) for an interval between a and b. Unbounded intervals have an infinite magnitude and the
+	Empty Interval returns NaN.
+
+	Return:
+		float64
+*/
+func (self Interval[N]) Magnitude() float64 {
+	if self.Type == EmptyInterval {
+		return math.NaN()
+	}
+	if self.LowerBound.IsInfinite() || self.UpperBound.IsInfinite() {
+		return math.Inf(1)
+	}
+	return math.Max(math.Abs(float64(self.LowerBound.Value)), math.Abs(float64(self.UpperBound.Value)))
+}
+
+/*
+	Public Method that returns the mignitude of an interval: the smallest absolute Value it approaches,
+	0 if the interval holds or approaches 0 and min(|a|, |b|) otherwise. The Empty Interval returns NaN.
+
+	Return:
+		float64
+*/
+func (self Interval[N]) Mignitude() float64 {
+	if self.Type == EmptyInterval {
+		return math.NaN()
+	}
+	lower, upper := math.Inf(-1), math.Inf(1)
+	if !self.LowerBound.IsInfinite() {
+		lower = float64(self.LowerBound.Value)
+	}
+	if !self.UpperBound.IsInfinite() {
+		upper = float64(self.UpperBound.Value)
+	}
+	switch {
+	case lower > 0:
+		return lower
+	case upper < 0:
+		return -upper
+	}
+	return 0
+}
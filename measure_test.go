@@ -0,0 +1,118 @@
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+/* SECTION: Measure Testing */
+
+func TestLength(t *testing.T) {
+	AssertEqual(GenerateClosedInterval(1.5, 4.0).Length(), 2.5, t)
+	AssertEqual(GenerateOpenInterval(1.5, 4.0).Length(), 2.5, t)
+	AssertEqual(GenerateClosedInterval(2, 5).Length(), 3.0, t)
+	AssertEqual(GenerateClosedInterval(3.0, 3.0).Length(), 0.0, t)
+	AssertEqual(GenerateEmptyInterval[float64]().Length(), 0.0, t)
+	AssertEqual(GenerateAtLeastInterval(1.0).Length(), math.Inf(1), t)
+	AssertEqual(GenerateUnboundedInterval[int]().Measure(), math.Inf(1), t)
+	AssertEqual(GenerateClosedOpenInterval(-1.0, 1.0).Measure(), 2.0, t)
+	AssertEqual(GenerateClosedInterval(-math.MaxFloat64, math.MaxFloat64).Length(), math.Inf(1), t)
+}
+
+func TestMidpointAndRadius(t *testing.T) {
+	tests := []struct {
+		interval Interval[float64]
+		midpoint float64
+		bounded  bool
+		radius   float64
+	}{
+		{GenerateClosedInterval(1.0, 4.0), 2.5, true, 1.5},
+		{GenerateOpenClosedInterval(-2.0, 2.0), 0, true, 2},
+		{GenerateClosedInterval(3.0, 3.0), 3, true, 0},
+		{GenerateClosedInterval(-math.MaxFloat64, math.MaxFloat64), 0, true, math.Inf(1)},
+		{GenerateClosedInterval(math.MaxFloat64/2, math.MaxFloat64), math.MaxFloat64 * 0.75, true, math.MaxFloat64 / 4},
+		{GenerateGreaterThanInterval(1.0), math.Inf(1), false, math.Inf(1)},
+		{GenerateAtMostInterval(1.0), math.Inf(-1), false, math.Inf(1)},
+	}
+	for _, test := range tests {
+		midpoint, ok := test.interval.Midpoint()
+		AssertEqual(midpoint, test.midpoint, t)
+		AssertEqual(ok, test.bounded, t)
+		AssertEqual(test.interval.Radius(), test.radius, t)
+	}
+	/* (-∞,+∞) has no midpoint, 0 would be indistinguishable from the midpoint of [-1,1] */
+	midpoint, ok := GenerateUnboundedInterval[float64]().Midpoint()
+	AssertTrue(math.IsNaN(midpoint) && !ok, t)
+	AssertEqual(GenerateUnboundedInterval[float64]().Radius(), math.Inf(1), t)
+	empty := GenerateEmptyInterval[float64]()
+	midpoint, ok = empty.Midpoint()
+	AssertTrue(math.IsNaN(midpoint) && !ok, t)
+	AssertTrue(math.IsNaN(empty.Radius()), t)
+}
+
+func TestMagnitudeAndMignitude(t *testing.T) {
+	tests := []struct {
+		interval  Interval[float64]
+		magnitude float64
+		mignitude float64
+	}{
+		{GenerateClosedInterval(1.0, 4.0), 4, 1},
+		{GenerateClosedInterval(-5.0, -2.0), 5, 2},
+		{GenerateOpenInterval(-3.0, 2.0), 3, 0},
+		{GenerateOpenInterval(0.0, 2.0), 2, 0},
+		{GenerateGreaterThanInterval(1.0), math.Inf(1), 1},
+		{GenerateLessThanInterval(-2.0), math.Inf(1), 2},
+		{GenerateAtMostInterval(1.0), math.Inf(1), 0},
+		{GenerateUnboundedInterval[float64](), math.Inf(1), 0},
+	}
+	for _, test := range tests {
+		AssertEqual(test.interval.Magnitude(), test.magnitude, t)
+		AssertEqual(test.interval.Mignitude(), test.mignitude, t)
+	}
+	empty := GenerateEmptyInterval[float64]()
+	AssertTrue(math.IsNaN(empty.Magnitude()), t)
+	AssertTrue(math.IsNaN(empty.Mignitude()), t)
+}
+
+func TestCardinality(t *testing.T) {
+	tests := []struct {
+		cardinality Cardinality
+		expected    Cardinality
+		notation    string
+	}{
+		{GenerateEmptyInterval[int]().Cardinality(), Cardinality{Kind: FiniteCardinality, Size: 0}, "0"},
+		{GenerateClosedInterval(4, 4).Cardinality(), Cardinality{Kind: FiniteCardinality, Size: 1}, "1"},
+		{GenerateOpenInterval(1, 5).Cardinality(), Cardinality{Kind: FiniteCardinality, Size: 3}, "3"},
+		{GenerateOpenInterval(1, 2).Cardinality(), Cardinality{Kind: FiniteCardinality, Size: 0}, "0"},
+		{GenerateAtLeastInterval(0).Cardinality(), Cardinality{Kind: CountableCardinality}, "ℵ₀"},
+		{GenerateUnboundedInterval[int8]().Cardinality(), Cardinality{Kind: CountableCardinality}, "ℵ₀"},
+		{GenerateClosedInterval(0.0, 1.0).Cardinality(), Cardinality{Kind: ContinuumCardinality}, "𝔠"},
+		{GenerateClosedInterval(0.5, 0.5).Cardinality(), Cardinality{Kind: FiniteCardinality, Size: 1}, "1"},
+		{GenerateClosedInterval[int64](math.MinInt64, math.MaxInt64-1).Cardinality(), Cardinality{Kind: FiniteCardinality, Size: math.MaxUint64}, "18446744073709551615"},
+		{GenerateClosedInterval[int64](math.MinInt64, math.MaxInt64).Cardinality(), Cardinality{Kind: FiniteCardinality, Size: math.MaxUint64}, "18446744073709551615"},
+		{GenerateClosedInterval[uint64](1, math.MaxUint64).Cardinality(), Cardinality{Kind: FiniteCardinality, Size: math.MaxUint64}, "18446744073709551615"},
+		{GenerateClosedInterval[int8](-128, 127).Cardinality(), Cardinality{Kind: FiniteCardinality, Size: 256}, "256"},
+	}
+	for _, test := range tests {
+		AssertEqual(test.cardinality, test.expected, t)
+		AssertEqual(test.cardinality.String(), test.notation, t)
+		AssertEqual(test.cardinality.IsFinite(), test.expected.Kind == FiniteCardinality, t)
+	}
+}
+
+func TestCountSaturates(t *testing.T) {
+	for _, interval := range []Interval[int64]{
+		GenerateAtLeastInterval[int64](0),
+		GenerateUnboundedInterval[int64](),
+		GenerateClosedInterval[int64](math.MinInt64, math.MaxInt64),
+		GenerateClosedInterval[int64](-1, math.MaxInt64),
+	} {
+		AssertEqual(interval.Count(), math.MaxInt, t)
+	}
+	interval := GenerateClosedInterval[int64](0, math.MaxInt64-1)
+	AssertEqual(interval.Count(), math.MaxInt, t)
+	interval = GenerateClosedInterval[int64](1, math.MaxInt64-1)
+	AssertEqual(interval.Count(), math.MaxInt-1, t)
+}
+
+/* !SECTION: Measure Testing */
@@ -0,0 +1,226 @@
+package interval
+
+import (
+	"container/heap"
+	"context"
+	"math"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	DefaultMinimizeTolerance     = 1e-8    // Tolerance used by Minimize when MinimizeOptions.Tolerance is 0
+	DefaultMinimizeMaxIterations = 1 << 16 // MaxIterations used by Minimize when MinimizeOptions.MaxIterations is 0
+)
+
+/* Box Type for a search region: one interval per dimension */
+type Box []Interval[float64]
+
+/* MinimizeOptions Type to tune Minimize. The zero Value uses the defaults. */
+type MinimizeOptions struct {
+	Tolerance     float64 // a Box is no longer split once f over it, or its widest side, is narrower
+	MaxIterations int     // the number of Boxes split before Minimize reports the Boxes left
+}
+
+/* Minimum Type for the result of Minimize */
+type Minimum struct {
+	Enclosure Interval[float64] // holds the global minimum of f, Empty if the region is Empty
+	Boxes     []Box             // hold every global minimizer of f, sorted by the lower bound of f over them
+}
+
+/* Private Method that returns the length of the widest side of a Box and the dimension it lies along */
+func (self Box) widest() (float64, int) {
+	width, dimension := 0.0, 0
+	for i, side := range self {
+		if length := side.Length(); length > width {
+			width, dimension = length, i
+		}
+	}
+	return width, dimension
+}
+
+/* Private Boolean Method that returns true if a side of the Box is Empty */
+func (self Box) isEmpty() bool {
+	for _, side := range self {
+		if side.Type == EmptyInterval {
+			return true
+		}
+	}
+	return false
+}
+
+/* Private Method that returns the point Box at the middle of a bounded Box, false if a side holds no float */
+func (self Box) center() (Box, bool) {
+	center := make(Box, len(self))
+	for i, side := range self {
+		point, ok := innerPoint(side)
+		if !ok {
+			return nil, false
+		}
+		center[i] = GenerateClosedInterval(point, point)
+	}
+	return center, true
+}
+
+/*
+	Private Method that splits a bounded Box in two along its widest side. The halves are disjoint: the middle
+	of the side is only held by the lower half. false if the widest side cannot be split.
+*/
+func (self Box) bisect() (lower, upper Box, ok bool) {
+	if len(self) == 0 {
+		return nil, nil, false
+	}
+	_, dimension := self.widest()
+	split, ok := innerPoint(self[dimension])
+	lower, upper = append(Box{}, self...), append(Box{}, self...)
+	lower[dimension] = Intersect(self[dimension], GenerateAtMostInterval(split))
+	upper[dimension] = Intersect(self[dimension], GenerateGreaterThanInterval(split))
+	if !ok || lower.isEmpty() || upper.isEmpty() {
+		return nil, nil, false
+	}
+	return lower, upper, true
+}
+
+/* Private Type for a Box waiting in a boxHeap with the enclosure of f over it */
+type boxEntry struct {
+	box   Box
+	image Interval[float64]
+}
+
+/* Private Method that returns the lower bound of f over a boxEntry, -Inf if it is unbounded below */
+func (self boxEntry) lower() float64 {
+	if self.image.LowerBound.IsInfinite() {
+		return math.Inf(-1)
+	}
+	return self.image.LowerBound.Value
+}
+
+/* Private Type for the Boxes left to search, a heap.Interface ordered by the lower bound of f over them */
+type boxHeap []boxEntry
+
+func (self boxHeap) Len() int           { return len(self) }
+func (self boxHeap) Less(i, j int) bool { return self[i].lower() < self[j].lower() }
+func (self boxHeap) Swap(i, j int)      { self[i], self[j] = self[j], self[i] }
+func (self *boxHeap) Push(x any)        { *self = append(*self, x.(boxEntry)) }
+func (self *boxHeap) Pop() any {
+	old := *self
+	last := old[len(old)-1]
+	*self = old[:len(old)-1]
+	return last
+}
+
+/*
+	Public Function that finds the global minimum of f over a region by branch and bound. f is an interval
+	extension: for every Box, f(Box) must enclose every f(x) for x in the Box, which holds for functions
+	composed of the Outward arithmetic and the elementary functions.
+
+	The Box with the lowest lower bound of f is split along its widest side until f over it, or its widest side,
+	is narrower than the Tolerance. The upper bound of f at the center of each Box bounds the minimum from above
+	and discards every Box whose lower bound of f is higher. The Enclosure runs from the lowest lower bound of f
+	over the Boxes left to that upper bound.
+
+	After MaxIterations splits, or once ctx is done, the Boxes still waiting are reported as they are: the
+	Enclosure and Boxes still hold the minimum and its minimizers, only less tightly. A done ctx also returns
+	ctx.Err() with this partial Minimum.
+
+	Parameters:
+		ctx context.Context
+		f func(Box) Interval[float64]
+		region Box	bounded Box to search, every side of which must be bounded
+		options MinimizeOptions
+	Return:
+		Minimum
+		error	ErrUnboundedInterval, ErrInvalidTolerance or ctx.Err()
+*/
+func Minimize(ctx context.Context, f func(Box) Interval[float64], region Box, options MinimizeOptions) (Minimum, error) {
+	for _, side := range region {
+		if side.Type != EmptyInterval && (side.LowerBound.IsInfinite() || side.UpperBound.IsInfinite()) {
+			return Minimum{}, ErrUnboundedInterval
+		}
+	}
+	tolerance, iterations := options.Tolerance, options.MaxIterations
+	if tolerance == 0 {
+		tolerance = DefaultMinimizeTolerance
+	}
+	if iterations <= 0 {
+		iterations = DefaultMinimizeMaxIterations
+	}
+	if !(tolerance > 0) || math.IsInf(tolerance, 1) {
+		return Minimum{}, ErrInvalidTolerance
+	}
+
+	best := math.Inf(1)
+	pending := &boxHeap{}
+	/* evaluates a Box, lowering the best upper bound with its center, and queues it unless it is discarded */
+	visit := func(box Box) {
+		if box.isEmpty() {
+			return
+		}
+		image := f(box)
+		if image.Type == EmptyInterval {
+			return
+		}
+		if center, ok := box.center(); ok {
+			if value := f(center); value.Type != EmptyInterval && !value.UpperBound.IsInfinite() {
+				best = math.Min(best, value.UpperBound.Value)
+			}
+		}
+		heap.Push(pending, boxEntry{box: box, image: image})
+	}
+	visit(region)
+
+	var done []boxEntry
+	var err error
+	for split := 0; pending.Len() > 0; {
+		if err = ctx.Err(); err != nil || split >= iterations {
+			break
+		}
+		next := heap.Pop(pending).(boxEntry)
+		if next.lower() > best {
+			/* every Box left lies above the minimum */
+			*pending = nil
+			break
+		}
+		width, _ := next.box.widest()
+		lower, upper, ok := next.box.bisect()
+		if next.image.Length() <= tolerance || width <= tolerance || !ok {
+			done = append(done, next)
+			continue
+		}
+		visit(lower)
+		visit(upper)
+		split++
+	}
+	for pending.Len() > 0 {
+		done = append(done, heap.Pop(pending).(boxEntry))
+	}
+
+	slices.SortFunc(done, func(a, b boxEntry) bool {
+		return a.lower() < b.lower()
+	})
+	minimum := Minimum{Enclosure: GenerateEmptyInterval[float64]()}
+	lowest := math.Inf(1)
+	for _, entry := range done {
+		if entry.lower() > best {
+			continue
+		}
+		lowest = math.Min(lowest, entry.lower())
+		minimum.Boxes = append(minimum.Boxes, entry.box)
+	}
+	if len(minimum.Boxes) > 0 {
+		minimum.Enclosure = minimumEnclosure(lowest, best)
+	}
+	return minimum, err
+}
+
+/* Private function that returns the interval between a lower and an upper bound, either of which may be infinite */
+func minimumEnclosure(lower, upper float64) Interval[float64] {
+	start, end := Point[float64]{Value: lower, Type: ClosedPoint}, Point[float64]{Value: upper, Type: ClosedPoint}
+	if math.IsInf(lower, -1) {
+		start = NegativeInfinity[float64]()
+	}
+	if math.IsInf(upper, 1) {
+		end = PositiveInfinity[float64]()
+	}
+	return GenerateInterval(start, end)
+}
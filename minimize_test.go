@@ -0,0 +1,107 @@
+package interval
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+/* SECTION: Minimize Testing */
+
+/* Private helper that returns f(x,y) = (x - 1)² + (y + 2)² + 3, whose minimum 3 lies at (1,-2) */
+func paraboloid(box Box) Interval[float64] {
+	x := Pow(OutwardSub(box[0], singleton(1)), 2)
+	y := Pow(OutwardAdd(box[1], singleton(2)), 2)
+	return OutwardAdd(OutwardAdd(x, y), singleton(3))
+}
+
+/* Private helper that returns the Boolean of whether any Box holds a point */
+func boxesHold(boxes []Box, point ...float64) bool {
+	for _, box := range boxes {
+		holds := true
+		for i, Value := range point {
+			holds = holds && box[i].Contains(Value)
+		}
+		if holds {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMinimize(t *testing.T) {
+	region := Box{GenerateClosedInterval(-5.0, 5.0), GenerateClosedInterval(-5.0, 5.0)}
+	minimum, err := Minimize(context.Background(), paraboloid, region, MinimizeOptions{Tolerance: 1e-6})
+	AssertTrue(err == nil, t)
+	AssertTrue(minimum.Enclosure.Contains(3), t)
+	AssertTrue(minimum.Enclosure.Length() <= 1e-6, t)
+	AssertTrue(boxesHold(minimum.Boxes, 1, -2), t)
+	for _, box := range minimum.Boxes {
+		AssertTrue(box[0].Contains(1) || box[0].Length() < 0.01, t)
+	}
+}
+
+func TestMinimizeSeveralMinimizers(t *testing.T) {
+	/* f(x) = (x² - 1)² has its minimum 0 at -1 and 1, and a local maximum at 0 */
+	f := func(box Box) Interval[float64] { return Pow(OutwardSub(Pow(box[0], 2), singleton(1)), 2) }
+	minimum, err := Minimize(context.Background(), f, Box{GenerateClosedInterval(-2.0, 3.0)}, MinimizeOptions{})
+	AssertTrue(err == nil, t)
+	AssertTrue(minimum.Enclosure.Contains(0), t)
+	AssertTrue(minimum.Enclosure.Length() <= DefaultMinimizeTolerance, t)
+	AssertTrue(boxesHold(minimum.Boxes, -1), t)
+	AssertTrue(boxesHold(minimum.Boxes, 1), t)
+	AssertFalse(boxesHold(minimum.Boxes, 0), t)
+	for i := 1; i < len(minimum.Boxes); i++ {
+		AssertTrue(f(minimum.Boxes[i-1]).LowerBound.Value <= f(minimum.Boxes[i]).LowerBound.Value, t)
+	}
+}
+
+func TestMinimizeMultimodal(t *testing.T) {
+	/* f(x) = sin(x) + sin(10x/3) over [2.7,7.5], whose global minimum -1.8996 lies near 5.1457 */
+	f := func(box Box) Interval[float64] {
+		return OutwardAdd(Sin(box[0]), Sin(OutwardMul(box[0], singleton(10.0/3))))
+	}
+	minimum, err := Minimize(context.Background(), f, Box{GenerateClosedInterval(2.7, 7.5)}, MinimizeOptions{Tolerance: 1e-9})
+	AssertTrue(err == nil, t)
+	AssertTrue(boxesHold(minimum.Boxes, 5.145735), t)
+	lowest := math.Inf(1)
+	for x := 2.7; x <= 7.5; x += 1e-4 {
+		lowest = math.Min(lowest, math.Sin(x)+math.Sin(10*x/3))
+	}
+	AssertTrue(minimum.Enclosure.LowerBound.Value <= lowest, t)
+	AssertTrue(minimum.Enclosure.UpperBound.Value-lowest <= 1e-8, t)
+}
+
+func TestMinimizeStops(t *testing.T) {
+	region := Box{GenerateClosedInterval(-5.0, 5.0), GenerateClosedInterval(-5.0, 5.0)}
+
+	/* a few splits leave a wider Enclosure that still holds the minimum */
+	minimum, err := Minimize(context.Background(), paraboloid, region, MinimizeOptions{MaxIterations: 3})
+	AssertTrue(err == nil, t)
+	AssertTrue(minimum.Enclosure.Contains(3), t)
+	AssertTrue(boxesHold(minimum.Boxes, 1, -2), t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	minimum, err = Minimize(ctx, paraboloid, region, MinimizeOptions{})
+	AssertTrue(errors.Is(err, context.Canceled), t)
+	AssertEqual(len(minimum.Boxes), 1, t)
+	AssertTrue(minimum.Enclosure.Contains(3), t)
+}
+
+func TestMinimizeErrors(t *testing.T) {
+	_, err := Minimize(context.Background(), paraboloid, Box{GenerateAtLeastInterval(0.0), GenerateClosedInterval(0.0, 1.0)}, MinimizeOptions{})
+	AssertTrue(errors.Is(err, ErrUnboundedInterval), t)
+	for _, tolerance := range []float64{-1, math.NaN(), math.Inf(1)} {
+		_, err = Minimize(context.Background(), paraboloid, Box{singleton(0), singleton(0)}, MinimizeOptions{Tolerance: tolerance})
+		AssertTrue(errors.Is(err, ErrInvalidTolerance), t)
+	}
+
+	minimum, err := Minimize(context.Background(), paraboloid, Box{GenerateEmptyInterval[float64](), singleton(0)}, MinimizeOptions{})
+	AssertTrue(err == nil, t)
+	AssertEqual(minimum.Enclosure.Type, EmptyInterval, t)
+	AssertEqual(len(minimum.Boxes), 0, t)
+}
+
+/* !SECTION: Minimize Testing */
@@ -0,0 +1,117 @@
+package interval
+
+import (
+	"math"
+
+	"golang.org/x/exp/slices"
+)
+
+/* the number of intervals Newton refines before it reports the intervals left as possible roots */
+const maxNewtonSteps = 1 << 16
+
+/* Root Type for an interval that encloses a root of a function, as found by Newton */
+type Root struct {
+	Interval Interval[float64]
+	Unique   bool // true if the interval is proven to hold exactly one root
+}
+
+/*
+	Private function that returns a Value within a bounded interval, to expand or split it at: its midpoint, or a Value
+	just inside its LowerBound if the midpoint rounds out of an Open interval. false if the interval holds no float.
+*/
+func innerPoint(interval Interval[float64]) (float64, bool) {
+	if midpoint, ok := interval.Midpoint(); ok && interval.Contains(midpoint) {
+		return midpoint, true
+	}
+	inside := interval.LowerBound.Value
+	if interval.LowerBound.Type == OpenPoint {
+		inside = nextValue(inside, true)
+	}
+	return inside, interval.Contains(inside)
+}
+
+/*
+	Public Function that finds every root of f within start with the interval Newton method. f and derivative
+	are interval extensions: for every interval X, f(X) must enclose every f(x) and derivative(X) every f'(x) for
+	x in X, which holds for functions composed of the Outward arithmetic and the elementary functions.
+
+	An interval X is contracted to X ∩ N(X), with the Newton operator N(X) = m - f(m) / f'(X) for a point m of X.
+	A derivative holding 0 splits N(X) in two, so separate roots are isolated even near tangencies where
+	float bisection misses them. An interval where f cannot be 0 is dropped, and an interval that barely
+	contracts is bisected. N(X) ⊆ X proves that X holds exactly one root.
+
+	The Roots are disjoint, sorted and together hold every root of f in start, each narrower than tolerance
+	unless it cannot be split further or the refinement stops after maxNewtonSteps intervals. A Root that is
+	not Unique may hold several roots, or none where f only comes close to 0.
+
+	Parameters:
+		f func(Interval[float64]) Interval[float64]
+		derivative func(Interval[float64]) Interval[float64]
+		start Interval[float64]	bounded interval to search
+		tolerance float64	width below which a Root is no longer refined
+	Return:
+		[]Root
+		error	ErrUnboundedInterval or ErrInvalidTolerance
+*/
+func Newton(f, derivative func(Interval[float64]) Interval[float64], start Interval[float64], tolerance float64) ([]Root, error) {
+	if start.Type != EmptyInterval && (start.LowerBound.IsInfinite() || start.UpperBound.IsInfinite()) {
+		return nil, ErrUnboundedInterval
+	}
+	if !(tolerance > 0) || math.IsInf(tolerance, 1) {
+		return nil, ErrInvalidTolerance
+	}
+	var roots []Root
+	pending := []Root{{Interval: start}}
+	for steps := 0; len(pending) > 0; steps++ {
+		next := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if next.Interval.Type == EmptyInterval || !f(next.Interval).Contains(0) {
+			continue
+		}
+		point, ok := innerPoint(next.Interval)
+		if steps >= maxNewtonSteps || next.Interval.Length() <= tolerance || !ok {
+			roots = append(roots, next)
+			continue
+		}
+		pending = append(pending, newtonStep(f, derivative, next, point)...)
+	}
+	slices.SortFunc(roots, func(a, b Root) bool {
+		return Compare(a.Interval, b.Interval) < 0
+	})
+	return roots, nil
+}
+
+/*
+	Private function that returns the intervals left of a Root by one Newton step around point: the
+	contraction X ∩ N(X), bisected if it is over half as wide as X.
+*/
+func newtonStep(f, derivative func(Interval[float64]) Interval[float64], root Root, point float64) []Root {
+	interval := root.Interval
+	center := GenerateClosedInterval(point, point)
+	value, slope := f(center), derivative(interval)
+	quotients := OutwardDiv(value, slope).Intervals()
+	/* a flat f'(ξ) = 0 solves f(m) = f'(ξ)(m - x) for every x once f(m) may be 0, so nothing is contracted */
+	if value.Contains(0) && slope.Contains(0) {
+		quotients = []Interval[float64]{GenerateUnboundedInterval[float64]()}
+	}
+	var contracted []Root
+	for _, quotient := range quotients {
+		newton := OutwardSub(center, quotient)
+		unique := root.Unique || (!slope.Contains(0) && interval.ContainsInterval(newton))
+		if part := Intersect(interval, newton); part.Type != EmptyInterval {
+			contracted = append(contracted, Root{Interval: part, Unique: unique})
+		}
+	}
+	if len(contracted) != 1 || contracted[0].Interval.Length() <= interval.Length()/2 {
+		return contracted
+	}
+	/* the halves are disjoint, so a root at the split is only held by the lower half */
+	next := contracted[0]
+	split, ok := innerPoint(next.Interval)
+	lower := Intersect(next.Interval, GenerateAtMostInterval(split))
+	upper := Intersect(next.Interval, GenerateGreaterThanInterval(split))
+	if !ok || upper.Type == EmptyInterval || lower.Type == EmptyInterval {
+		return contracted
+	}
+	return []Root{{Interval: upper}, {Interval: lower}}
+}
@@ -0,0 +1,89 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+/* SECTION: Newton Testing */
+
+/* Private helper that returns the closed interval holding only Value */
+func singleton(Value float64) Interval[float64] {
+	return GenerateClosedInterval(Value, Value)
+}
+
+func TestNewtonUniqueRoots(t *testing.T) {
+	/* f(x) = x² - 2 */
+	f := func(x Interval[float64]) Interval[float64] { return OutwardSub(Pow(x, 2), singleton(2)) }
+	derivative := func(x Interval[float64]) Interval[float64] { return OutwardMul(singleton(2), x) }
+	roots, err := Newton(f, derivative, GenerateClosedInterval(-3.0, 3.0), 1e-12)
+	AssertTrue(err == nil, t)
+	AssertEqual(len(roots), 2, t)
+	for i, root := range []float64{-math.Sqrt2, math.Sqrt2} {
+		AssertTrue(roots[i].Unique, t)
+		AssertTrue(roots[i].Interval.Contains(root), t)
+		AssertTrue(roots[i].Interval.Length() <= 1e-12, t)
+	}
+}
+
+func TestNewtonCloseRoots(t *testing.T) {
+	/* f(x) = x² - 1e-10 is at most 1e-10 from 0 on [-1e-5,1e-5], float bisection of [-1,1] misses both roots */
+	f := func(x Interval[float64]) Interval[float64] { return OutwardSub(Pow(x, 2), singleton(1e-10)) }
+	derivative := func(x Interval[float64]) Interval[float64] { return OutwardMul(singleton(2), x) }
+	roots, err := Newton(f, derivative, GenerateClosedInterval(-1.0, 1.0), 1e-15)
+	AssertTrue(err == nil, t)
+	AssertEqual(len(roots), 2, t)
+	AssertTrue(roots[0].Unique && roots[0].Interval.Contains(-1e-5), t)
+	AssertTrue(roots[1].Unique && roots[1].Interval.Contains(1e-5), t)
+}
+
+func TestNewtonTangency(t *testing.T) {
+	/* f(x) = (x - 1)² touches 0 at 1 without crossing it, so its root cannot be proven unique */
+	f := func(x Interval[float64]) Interval[float64] { return Pow(OutwardSub(x, singleton(1)), 2) }
+	derivative := func(x Interval[float64]) Interval[float64] {
+		return OutwardMul(singleton(2), OutwardSub(x, singleton(1)))
+	}
+	roots, err := Newton(f, derivative, GenerateClosedInterval(-3.0, 3.0), 1e-9)
+	AssertTrue(err == nil, t)
+	AssertEqual(len(roots), 1, t)
+	AssertFalse(roots[0].Unique, t)
+	AssertTrue(roots[0].Interval.Contains(1), t)
+	AssertTrue(roots[0].Interval.Length() <= 1e-9, t)
+}
+
+func TestNewtonPeriodic(t *testing.T) {
+	roots, err := Newton(Sin, Cos, GenerateClosedInterval(-10.0, 10.0), 1e-12)
+	AssertTrue(err == nil, t)
+	AssertEqual(len(roots), 7, t)
+	for i, root := range roots {
+		AssertTrue(root.Interval.Contains(float64(i-3)*math.Pi), t)
+	}
+	AssertTrue(roots[0].Unique && roots[6].Unique, t)
+}
+
+func TestNewtonNoRoots(t *testing.T) {
+	/* f(x) = x² + 1 */
+	f := func(x Interval[float64]) Interval[float64] { return OutwardAdd(Pow(x, 2), singleton(1)) }
+	derivative := func(x Interval[float64]) Interval[float64] { return OutwardMul(singleton(2), x) }
+	roots, err := Newton(f, derivative, GenerateClosedInterval(-3.0, 3.0), 1e-12)
+	AssertTrue(err == nil, t)
+	AssertEqual(len(roots), 0, t)
+
+	roots, err = Newton(f, derivative, GenerateEmptyInterval[float64](), 1e-12)
+	AssertTrue(err == nil, t)
+	AssertEqual(len(roots), 0, t)
+}
+
+func TestNewtonErrors(t *testing.T) {
+	identity := func(x Interval[float64]) Interval[float64] { return x }
+	one := func(x Interval[float64]) Interval[float64] { return singleton(1) }
+	_, err := Newton(identity, one, GenerateAtLeastInterval(0.0), 1e-12)
+	AssertTrue(errors.Is(err, ErrUnboundedInterval), t)
+	for _, tolerance := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		_, err = Newton(identity, one, GenerateClosedInterval(-1.0, 1.0), tolerance)
+		AssertTrue(errors.Is(err, ErrInvalidTolerance), t)
+	}
+}
+
+/* !SECTION: Newton Testing */
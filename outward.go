@@ -0,0 +1,69 @@
+package interval
+
+import "golang.org/x/exp/constraints"
+
+/* SECTION: Outward-Rounded Interval Arithmetic Functions */
+
+/*
+	Public Interval Function that returns the sum of two float intervals rounded outwards: a LowerBound that
+	the float sum rounded up is stepped down to the previous float and an UpperBound that it rounded down is
+	stepped up to the next float, so the result always encloses the exact real sum. Exact endpoints are kept,
+	ex: [0.1,0.2] + [0.2,0.3] has the float64 endpoints 0.30000000000000004 and 0.5 while the exact real
+	LowerBound 0.1 + 0.2 lies below the float sum, so the result starts at 0.3.
+
+	See Add for Open endpoints and overflows; an UpperBound stepped beyond the largest float becomes +∞.
+	Negation is always exact, so Neg needs no outward counterpart.
+
+	Parameters:
+		a Interval[F]
+		b Interval[F]
+	Return:
+		c Interval[F]	a + b, Empty if either is Empty
+*/
+func OutwardAdd[F constraints.Float](a, b Interval[F]) Interval[F] {
+	return addIntervals(a, b, true)
+}
+
+/*
+	Public Interval Function that returns the difference of two float intervals rounded outwards. See OutwardAdd
+	and Sub.
+
+	Parameters:
+		a Interval[F]
+		b Interval[F]
+	Return:
+		c Interval[F]	a - b, Empty if either is Empty
+*/
+func OutwardSub[F constraints.Float](a, b Interval[F]) Interval[F] {
+	return subIntervals(a, b, true)
+}
+
+/*
+	Public Interval Function that returns the product of two float intervals rounded outwards. See OutwardAdd
+	and Mul.
+
+	Parameters:
+		a Interval[F]
+		b Interval[F]
+	Return:
+		c Interval[F]	a * b, Empty if either is Empty
+*/
+func OutwardMul[F constraints.Float](a, b Interval[F]) Interval[F] {
+	return mulIntervals(a, b, true)
+}
+
+/*
+	Public IntervalSet Function that returns the quotient of two float intervals rounded outwards. See
+	OutwardAdd and Div.
+
+	Parameters:
+		a Interval[F]
+		b Interval[F]
+	Return:
+		c IntervalSet[F]	a / b
+*/
+func OutwardDiv[F constraints.Float](a, b Interval[F]) IntervalSet[F] {
+	return divIntervals(a, b, true)
+}
+
+/* !SECTION: Outward-Rounded Interval Arithmetic Functions */
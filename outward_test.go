@@ -0,0 +1,132 @@
+package interval
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"golang.org/x/exp/constraints"
+)
+
+/* SECTION: Outward Rounding Testing */
+
+func TestOutwardArithmetic(t *testing.T) {
+	tests := []struct {
+		result   Interval[float64]
+		expected string
+	}{
+		{OutwardAdd(GenerateClosedInterval(0.1, 0.2), GenerateClosedInterval(0.2, 0.3)), "[0.3,0.5]"},
+		{OutwardAdd(GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(3.0, 5.0)), "[4,7]"},
+		{OutwardAdd(GenerateOpenInterval(1.0, 2.0), GenerateAtLeastInterval(3.0)), "(4,+∞)"},
+		{OutwardSub(GenerateClosedInterval(0.3, 0.3), GenerateClosedInterval(0.1, 0.1)), "[0.19999999999999998,0.19999999999999998]"},
+		{OutwardMul(GenerateClosedInterval(0.1, 0.1), GenerateClosedInterval(3.0, 3.0)), "[0.3,0.30000000000000004]"},
+		{OutwardMul(GenerateClosedInterval(0.0, 1.0), GenerateOpenInterval(2.0, 3.0)), "[0,3)"},
+		{OutwardAdd(GenerateClosedInterval(math.MaxFloat64, math.MaxFloat64), GenerateClosedInterval(1.0, 1.0)), "[1.7976931348623157e+308,+∞)"},
+		{OutwardMul(GenerateClosedInterval(1e-300, 1e-300), GenerateClosedInterval(1e-300, 1e-300)), "[0,5e-324]"},
+	}
+	for _, test := range tests {
+		AssertEqual(test.result.String(), test.expected, t)
+	}
+	quotient := OutwardDiv(GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(3.0, 3.0))
+	AssertEqual(quotient.String(), "[0.3333333333333333,0.6666666666666667]", t)
+	quotient = OutwardDiv(GenerateClosedInterval(1.0, 2.0), GenerateClosedInterval(-1.0, 1.0))
+	AssertEqual(quotient.String(), "(-∞,-1] ∪ [1,+∞)", t)
+	AssertEqual(OutwardAdd(GenerateEmptyInterval[float64](), GenerateClosedInterval(1.0, 2.0)).Type, EmptyInterval, t)
+
+	/* rounded to nearest, the float32 sum loses the exact LowerBound */
+	sum := OutwardAdd(GenerateClosedInterval[float32](0.1, 0.1), GenerateClosedInterval[float32](0.2, 0.2))
+	AssertEqual(sum.String(), "[0.29999998,0.3]", t)
+}
+
+/* Private helper that returns a random float64 with a random sign and an exponent within ±scale */
+func randomFloat(random *rand.Rand, scale int) float64 {
+	Value := math.Ldexp(random.Float64()+0.5, random.Intn(2*scale+1)-scale)
+	if random.Intn(2) == 0 {
+		return -Value
+	}
+	return Value
+}
+
+/* Private helper that returns the closed interval between two Values in either order */
+func randomInterval[F constraints.Float](x, y F) Interval[F] {
+	return GenerateClosedInterval(Min(x, y), Max(x, y))
+}
+
+/* Private helper that returns the exact rational Value of a finite float */
+func exactRat[F constraints.Float](Value F) *big.Rat {
+	return new(big.Rat).SetFloat64(float64(Value))
+}
+
+/*
+	Private helper that checks that an outward-rounded interval encloses the exact rationals lower and upper
+	and is tight: stepping either finite endpoint inwards by one float would exclude them.
+*/
+func assertOutward[F constraints.Float](result Interval[F], lower, upper *big.Rat, t *testing.T) {
+	t.Helper()
+	if !result.LowerBound.IsInfinite() {
+		AssertTrue(exactRat(result.LowerBound.Value).Cmp(lower) <= 0, t)
+		if inward := nextValue(result.LowerBound.Value, true); !isInf(inward) {
+			AssertTrue(exactRat(inward).Cmp(lower) > 0, t)
+		}
+	}
+	if !result.UpperBound.IsInfinite() {
+		AssertTrue(exactRat(result.UpperBound.Value).Cmp(upper) >= 0, t)
+		if inward := nextValue(result.UpperBound.Value, false); !isInf(inward) {
+			AssertTrue(exactRat(inward).Cmp(upper) < 0, t)
+		}
+	}
+}
+
+/* Private helper that returns the least and greatest of rationals */
+func ratHull(Values ...*big.Rat) (lower, upper *big.Rat) {
+	lower, upper = Values[0], Values[0]
+	for _, Value := range Values[1:] {
+		if Value.Cmp(lower) < 0 {
+			lower = Value
+		}
+		if Value.Cmp(upper) > 0 {
+			upper = Value
+		}
+	}
+	return lower, upper
+}
+
+/* Private helper that checks every outward operation on two closed float intervals against big.Rat */
+func assertOutwardOperations[F constraints.Float](a, b Interval[F], t *testing.T) {
+	t.Helper()
+	aLower, aUpper := exactRat(a.LowerBound.Value), exactRat(a.UpperBound.Value)
+	bLower, bUpper := exactRat(b.LowerBound.Value), exactRat(b.UpperBound.Value)
+
+	assertOutward(OutwardAdd(a, b), new(big.Rat).Add(aLower, bLower), new(big.Rat).Add(aUpper, bUpper), t)
+	assertOutward(OutwardSub(a, b), new(big.Rat).Sub(aLower, bUpper), new(big.Rat).Sub(aUpper, bLower), t)
+	lower, upper := ratHull(
+		new(big.Rat).Mul(aLower, bLower), new(big.Rat).Mul(aLower, bUpper),
+		new(big.Rat).Mul(aUpper, bLower), new(big.Rat).Mul(aUpper, bUpper))
+	assertOutward(OutwardMul(a, b), lower, upper, t)
+	if b.Contains(0) {
+		return
+	}
+	lower, upper = ratHull(
+		new(big.Rat).Quo(aLower, bLower), new(big.Rat).Quo(aLower, bUpper),
+		new(big.Rat).Quo(aUpper, bLower), new(big.Rat).Quo(aUpper, bUpper))
+	quotient := OutwardDiv(a, b).Intervals()
+	AssertEqual(len(quotient), 1, t)
+	assertOutward(quotient[0], lower, upper, t)
+}
+
+func TestOutwardEnclosesExact(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		scale := []int{4, 60, 1000}[i%3]
+		a := randomInterval(randomFloat(random, scale), randomFloat(random, scale))
+		b := randomInterval(randomFloat(random, scale), randomFloat(random, scale))
+		assertOutwardOperations(a, b, t)
+
+		a32 := randomInterval(float32(randomFloat(random, 60)), float32(randomFloat(random, 60)))
+		b32 := randomInterval(float32(randomFloat(random, 60)), float32(randomFloat(random, 60)))
+		assertOutwardOperations(a32, b32, t)
+	}
+}
+
+/* !SECTION: Outward Rounding Testing */
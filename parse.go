@@ -0,0 +1,379 @@
+package interval
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+	ParseError Type to report input that is not valid interval or set notation.
+	Offset is the byte offset into Input where the problem was detected. Err holds the underlying
+	*strconv.NumError or *BoundsError[N] when there is one.
+*/
+type ParseError struct {
+	Input  string
+	Offset int
+	Msg    string
+	Err    error
+}
+
+func (self *ParseError) Error() string {
+	return fmt.Sprintf("cannot parse %q at offset %d: %s", self.Input, self.Offset, self.Msg)
+}
+
+func (self *ParseError) Unwrap() error {
+	return self.Err
+}
+
+/* Private parser state shared by the interval and set notation parsers. */
+type parser struct {
+	input  string
+	offset int
+}
+
+/* Private method that returns a *ParseError located at offset. */
+func (self *parser) errorAt(offset int, err error, format string, args ...any) error {
+	return &ParseError{Input: self.input, Offset: offset, Msg: fmt.Sprintf(format, args...), Err: err}
+}
+
+/* Private void method that skips whitespace. */
+func (self *parser) skipSpace() {
+	for self.offset < len(self.input) {
+		r, size := utf8.DecodeRuneInString(self.input[self.offset:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		self.offset += size
+	}
+}
+
+/* Private Boolean method that consumes the first of the literals found at the current offset, if any. */
+func (self *parser) consume(literals ...string) (string, bool) {
+	for _, literal := range literals {
+		if strings.HasPrefix(self.input[self.offset:], literal) {
+			self.offset += len(literal)
+			return literal, true
+		}
+	}
+	return "", false
+}
+
+/* Private method that returns the next rune as text for error messages. */
+func (self *parser) peek() string {
+	if self.offset >= len(self.input) {
+		return "end of input"
+	}
+	r, _ := utf8.DecodeRuneInString(self.input[self.offset:])
+	return strconv.QuoteRune(r)
+}
+
+/* Private method that expects a literal at the current offset, after optional whitespace. */
+func (self *parser) expect(literals ...string) (string, error) {
+	self.skipSpace()
+	if literal, ok := self.consume(literals...); ok {
+		return literal, nil
+	}
+	return "", self.errorAt(self.offset, nil, "expected %s, found %s", strings.Join(literals, " or "), self.peek())
+}
+
+/* Private method that fails unless only whitespace is left. */
+func (self *parser) expectEnd() error {
+	self.skipSpace()
+	if self.offset < len(self.input) {
+		return self.errorAt(self.offset, nil, "unexpected %s after notation", self.peek())
+	}
+	return nil
+}
+
+/* Private method that reads a token up to whitespace or one of the stop characters. */
+func (self *parser) token(stop string) (string, int) {
+	self.skipSpace()
+	start := self.offset
+	for self.offset < len(self.input) {
+		r, size := utf8.DecodeRuneInString(self.input[self.offset:])
+		if unicode.IsSpace(r) || strings.ContainsRune(stop, r) {
+			break
+		}
+		self.offset += size
+	}
+	return self.input[start:self.offset], start
+}
+
+/*
+	Private method that reads an endpoint Value or an infinity.
+
+	Return:
+		Value N			the endpoint Value, zero for infinities
+		infinity int	-1 for -∞, +1 for +∞ and 0 for a finite Value
+		offset int		where the endpoint starts
+		error
+*/
+func parseEndpoint[N Numeric](self *parser, stop string) (Value N, infinity int, offset int, err error) {
+	text, offset := self.token(stop)
+	switch strings.ToLower(text) {
+	case "":
+		return Value, 0, offset, self.errorAt(offset, nil, "expected an endpoint, found %s", self.peek())
+	case "-∞", "-inf":
+		return Value, -1, offset, nil
+	case "+∞", "∞", "+inf", "inf":
+		return Value, 1, offset, nil
+	}
+	Value, err = parseValue[N](text)
+	if err != nil {
+		return Value, 0, offset, self.errorAt(offset, err, "invalid endpoint %q", text)
+	}
+	return Value, 0, offset, nil
+}
+
+/* Private function that parses a finite Value of type N, rejecting NaN, infinities and out of range Values. */
+func parseValue[N Numeric](text string) (N, error) {
+	switch {
+	case isFloat[N]():
+		bits := 64
+		if isFloat32[N]() {
+			bits = 32
+		}
+		f, err := strconv.ParseFloat(text, bits)
+		if err == nil && (math.IsNaN(f) || math.IsInf(f, 0)) {
+			err = &strconv.NumError{Func: "ParseFloat", Num: text, Err: strconv.ErrSyntax}
+		}
+		return N(f), err
+	case isUnsigned[N]():
+		u, err := strconv.ParseUint(text, 10, 64)
+		if err == nil && uint64(N(u)) != u {
+			err = &strconv.NumError{Func: "ParseUint", Num: text, Err: strconv.ErrRange}
+		}
+		return N(u), err
+	}
+	i, err := strconv.ParseInt(text, 10, 64)
+	if err == nil && int64(N(i)) != i {
+		err = &strconv.NumError{Func: "ParseInt", Num: text, Err: strconv.ErrRange}
+	}
+	return N(i), err
+}
+
+/* Private function that builds an interval from parsed endpoints, reporting construction errors at offset. */
+func parsedInterval[N Numeric](self *parser, start, end Point[N], offset int) (Interval[N], error) {
+	interval, err := createInterval(start, end)
+	if err != nil {
+		return interval, self.errorAt(offset, err, "%v", err)
+	}
+	return interval, nil
+}
+
+/*
+	Public Function that parses the Interval Notation produced by String(), ex: [1,5), (-∞,3], (2,+∞) or {}.
+	Infinities may also be written -inf, inf and +inf, and whitespace is allowed around every token.
+
+	Parameters:
+		notation string
+	Return:
+		Interval[N] Interval Struct
+		error		*ParseError
+*/
+func ParseInterval[N Numeric](notation string) (Interval[N], error) {
+	self := &parser{input: notation}
+	opening, err := self.expect("{", "(", "[")
+	if err != nil {
+		return Interval[N]{}, err
+	}
+	if opening == "{" {
+		if _, err := self.expect("}"); err != nil {
+			return Interval[N]{}, err
+		}
+		return GenerateEmptyInterval[N](), self.expectEnd()
+	}
+
+	lower, lowerInfinity, lowerOffset, err := parseEndpoint[N](self, ",)]")
+	if err != nil {
+		return Interval[N]{}, err
+	}
+	start := Point[N]{Value: lower, Type: ClosedPoint}
+	if opening == "(" {
+		start.Type = OpenPoint
+	}
+	switch {
+	case lowerInfinity < 0 && opening == "[":
+		return Interval[N]{}, self.errorAt(lowerOffset, nil, "-∞ must be preceded by (")
+	case lowerInfinity < 0:
+		start = NegativeInfinity[N]()
+	case lowerInfinity > 0:
+		return Interval[N]{}, self.errorAt(lowerOffset, nil, "the LowerBound endpoint cannot be +∞")
+	}
+
+	if _, err := self.expect(","); err != nil {
+		return Interval[N]{}, err
+	}
+
+	upper, upperInfinity, upperOffset, err := parseEndpoint[N](self, ",)]")
+	if err != nil {
+		return Interval[N]{}, err
+	}
+	closing, err := self.expect(")", "]")
+	if err != nil {
+		return Interval[N]{}, err
+	}
+	end := Point[N]{Value: upper, Type: ClosedPoint}
+	if closing == ")" {
+		end.Type = OpenPoint
+	}
+	switch {
+	case upperInfinity > 0 && closing == "]":
+		return Interval[N]{}, self.errorAt(upperOffset, nil, "+∞ must be followed by )")
+	case upperInfinity > 0:
+		end = PositiveInfinity[N]()
+	case upperInfinity < 0:
+		return Interval[N]{}, self.errorAt(upperOffset, nil, "the UpperBound endpoint cannot be -∞")
+	}
+
+	if err := self.expectEnd(); err != nil {
+		return Interval[N]{}, err
+	}
+	return parsedInterval(self, start, end, lowerOffset)
+}
+
+/* Relations accepted between a Value and the variable of a set-builder notation. */
+var (
+	lessOperators    = []string{"≤", "<=", "<"}
+	greaterOperators = []string{"≥", ">=", ">"}
+)
+
+/* Private function that returns the PointType an inequality operator gives its endpoint. */
+func operatorPointType(operator string) PointType {
+	if operator == "<" || operator == ">" {
+		return OpenPoint
+	}
+	return ClosedPoint
+}
+
+/*
+	Public Function that parses the set notation produced by SetNotation(), ex: {x | 1 ≤ x < 5}, {x | x > 2},
+	{x | -∞ < x < +∞}, {3} or {}. The ASCII operators <= and >= may be used in place of ≤ and ≥.
+
+	Parameters:
+		notation string
+	Return:
+		Interval[N] Interval Struct
+		error		*ParseError
+*/
+func ParseSetNotation[N Numeric](notation string) (Interval[N], error) {
+	self := &parser{input: notation}
+	if _, err := self.expect("{"); err != nil {
+		return Interval[N]{}, err
+	}
+	self.skipSpace()
+	if _, ok := self.consume("}"); ok {
+		return GenerateEmptyInterval[N](), self.expectEnd()
+	}
+
+	/* {x | …} or the degenerate {a} */
+	variable, variableOffset := self.token("|}<>≤≥")
+	self.skipSpace()
+	if _, ok := self.consume("|"); !ok {
+		self.offset = variableOffset
+		Value, infinity, offset, err := parseEndpoint[N](self, "}")
+		if err != nil {
+			return Interval[N]{}, err
+		}
+		if infinity != 0 {
+			return Interval[N]{}, self.errorAt(offset, nil, "a set cannot hold an infinity")
+		}
+		if _, err := self.expect("}"); err != nil {
+			return Interval[N]{}, err
+		}
+		if err := self.expectEnd(); err != nil {
+			return Interval[N]{}, err
+		}
+		point := Point[N]{Value: Value, Type: ClosedPoint}
+		return parsedInterval(self, point, point, offset)
+	}
+	if !isIdentifier(variable) {
+		return Interval[N]{}, self.errorAt(variableOffset, nil, "expected a variable name, found %q", variable)
+	}
+
+	start, end := NegativeInfinity[N](), PositiveInfinity[N]()
+	self.skipSpace()
+	conditionOffset := self.offset
+	if _, ok := self.consume(variable); ok {
+		/* {x | x op a} */
+		operator, err := self.expect(append(append([]string{}, lessOperators...), greaterOperators...)...)
+		if err != nil {
+			return Interval[N]{}, err
+		}
+		Value, infinity, offset, err := parseEndpoint[N](self, "}")
+		if err != nil {
+			return Interval[N]{}, err
+		}
+		if infinity != 0 {
+			return Interval[N]{}, self.errorAt(offset, nil, "a one-sided condition needs a finite endpoint")
+		}
+		point := Point[N]{Value: Value, Type: operatorPointType(operator)}
+		if strings.ContainsAny(operator, "<≤") {
+			end = point
+		} else {
+			start = point
+		}
+	} else {
+		/* {x | a op x op b} */
+		lower, lowerInfinity, lowerOffset, err := parseEndpoint[N](self, "<≤")
+		if err != nil {
+			return Interval[N]{}, err
+		}
+		lowerOperator, err := self.expect(lessOperators...)
+		if err != nil {
+			return Interval[N]{}, err
+		}
+		if _, err := self.expect(variable); err != nil {
+			return Interval[N]{}, err
+		}
+		upperOperator, err := self.expect(lessOperators...)
+		if err != nil {
+			return Interval[N]{}, err
+		}
+		upper, upperInfinity, upperOffset, err := parseEndpoint[N](self, "}")
+		if err != nil {
+			return Interval[N]{}, err
+		}
+		switch {
+		case lowerInfinity > 0:
+			return Interval[N]{}, self.errorAt(lowerOffset, nil, "the LowerBound endpoint cannot be +∞")
+		case lowerInfinity < 0 && lowerOperator != "<":
+			return Interval[N]{}, self.errorAt(lowerOffset, nil, "-∞ must be followed by <")
+		case lowerInfinity == 0:
+			start = Point[N]{Value: lower, Type: operatorPointType(lowerOperator)}
+		}
+		switch {
+		case upperInfinity < 0:
+			return Interval[N]{}, self.errorAt(upperOffset, nil, "the UpperBound endpoint cannot be -∞")
+		case upperInfinity > 0 && upperOperator != "<":
+			return Interval[N]{}, self.errorAt(upperOffset, nil, "+∞ must be preceded by <")
+		case upperInfinity == 0:
+			end = Point[N]{Value: upper, Type: operatorPointType(upperOperator)}
+		}
+	}
+
+	if _, err := self.expect("}"); err != nil {
+		return Interval[N]{}, err
+	}
+	if err := self.expectEnd(); err != nil {
+		return Interval[N]{}, err
+	}
+	return parsedInterval(self, start, end, conditionOffset)
+}
+
+/* Private Boolean function that returns true if text is a variable name such as x. */
+func isIdentifier(text string) bool {
+	if text == "" {
+		return false
+	}
+	for _, r := range text {
+		if !unicode.IsLetter(r) && r != '_' {
+			return false
+		}
+	}
+	return true
+}
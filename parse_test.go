@@ -0,0 +1,191 @@
+package interval
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+/* SECTION: Parse Testing */
+
+/* every interval Type, with integer and float endpoints */
+func roundTripIntervals() ([]Interval[int], []Interval[float64]) {
+	integers := []Interval[int]{
+		GenerateEmptyInterval[int](),
+		GenerateClosedInterval(3, 3),
+		GenerateOpenInterval(-4, 9),
+		GenerateClosedInterval(0, 9),
+		GenerateOpenClosedInterval(1, 5),
+		GenerateClosedOpenInterval(-10, -2),
+		GenerateGreaterThanInterval(2),
+		GenerateAtLeastInterval(-7),
+		GenerateLessThanInterval(0),
+		GenerateAtMostInterval(3),
+		GenerateUnboundedInterval[int](),
+	}
+	floats := []Interval[float64]{
+		GenerateClosedOpenInterval(0.25, 1.5),
+		GenerateAtLeastInterval(1e21),
+		GenerateOpenInterval(-1.5, 2.75),
+		GenerateAtMostInterval(1e-7),
+		GenerateGreaterThanInterval(-0.1),
+		GenerateClosedInterval(3.5, 3.5),
+	}
+	return integers, floats
+}
+
+/* Private helper that asserts two intervals describe the same bounds */
+func assertSameInterval[N Numeric](value, expected Interval[N], t *testing.T) {
+	AssertEqual(value.Type, expected.Type, t)
+	AssertEqual(value.LowerBound, expected.LowerBound, t)
+	AssertEqual(value.UpperBound, expected.UpperBound, t)
+	AssertEqual(value.String(), expected.String(), t)
+}
+
+func TestParseIntervalRoundTrip(t *testing.T) {
+	integers, floats := roundTripIntervals()
+	for _, interval := range integers {
+		parsed, err := ParseInterval[int](interval.String())
+		AssertTrue(err == nil, t)
+		assertSameInterval(parsed, interval, t)
+	}
+	for _, interval := range floats {
+		parsed, err := ParseInterval[float64](interval.String())
+		AssertTrue(err == nil, t)
+		assertSameInterval(parsed, interval, t)
+	}
+}
+
+func TestParseSetNotationRoundTrip(t *testing.T) {
+	integers, floats := roundTripIntervals()
+	for _, interval := range integers {
+		parsed, err := ParseSetNotation[int](interval.SetNotation())
+		AssertTrue(err == nil, t)
+		assertSameInterval(parsed, interval, t)
+	}
+	for _, interval := range floats {
+		parsed, err := ParseSetNotation[float64](interval.SetNotation())
+		AssertTrue(err == nil, t)
+		assertSameInterval(parsed, interval, t)
+	}
+}
+
+func TestParseIntervalAlternatives(t *testing.T) {
+	tests := []struct {
+		notation string
+		expected string
+	}{
+		{" [ 1 , 5 ) ", "[1,5)"},
+		{"(-inf,3]", "(-∞,3]"},
+		{"(2, inf)", "(2,+∞)"},
+		{"(2,+INF)", "(2,+∞)"},
+		{"(2,∞)", "(2,+∞)"},
+		{"(-Inf, +inf)", "(-∞,+∞)"},
+		{"{ }", "{}"},
+		{"(2,2)", "{}"},
+	}
+	for _, test := range tests {
+		parsed, err := ParseInterval[int](test.notation)
+		AssertTrue(err == nil, t)
+		AssertEqual(parsed.String(), test.expected, t)
+	}
+}
+
+func TestParseSetNotationAlternatives(t *testing.T) {
+	tests := []struct {
+		notation string
+		expected string
+	}{
+		{"{x | 1 <= x < 5}", "[1,5)"},
+		{"{ y|y>=2 }", "[2,+∞)"},
+		{"{x | -inf < x <= 3}", "(-∞,3]"},
+		{"{x | x < 0}", "(-∞,0)"},
+		{"{ 4 }", "[4,4]"},
+	}
+	for _, test := range tests {
+		parsed, err := ParseSetNotation[int](test.notation)
+		AssertTrue(err == nil, t)
+		AssertEqual(parsed.String(), test.expected, t)
+	}
+}
+
+func TestParseIntervalErrors(t *testing.T) {
+	tests := []struct {
+		notation string
+		offset   int
+	}{
+		{"", 0},
+		{"1,5]", 0},
+		{"[1;5]", 1},
+		{"[1,5", 4},
+		{"[1 5]", 3},
+		{"[,5]", 1},
+		{"[1,5]x", 5},
+		{"[-∞,5]", 1},
+		{"(1,+∞]", 3},
+		{"(+∞,5)", 1},
+		{"(1,-inf)", 3},
+		{"[1.5,2]", 1},
+		{"[ 5,1]", 2},
+		{"{ ]", 2},
+	}
+	for _, test := range tests {
+		_, err := ParseInterval[int](test.notation)
+		var parseErr *ParseError
+		AssertTrue(errors.As(err, &parseErr), t)
+		if parseErr != nil {
+			AssertEqual(parseErr.Offset, test.offset, t)
+			AssertEqual(parseErr.Input, test.notation, t)
+		}
+	}
+}
+
+func TestParseIntervalUnderlyingErrors(t *testing.T) {
+	_, err := ParseInterval[int]("[5,1]")
+	AssertTrue(errors.Is(err, ErrInvertedBounds), t)
+
+	_, err = ParseInterval[int8]("[1,300]")
+	AssertTrue(errors.Is(err, strconv.ErrRange), t)
+
+	_, err = ParseInterval[uint]("[-1,3]")
+	AssertTrue(errors.Is(err, strconv.ErrSyntax), t)
+
+	_, err = ParseInterval[float64]("[NaN,3]")
+	AssertTrue(errors.Is(err, strconv.ErrSyntax), t)
+
+	_, err = ParseInterval[float32]("[1,1e39]")
+	AssertTrue(errors.Is(err, strconv.ErrRange), t)
+
+	_, err = ParseInterval[int]("[1,5")
+	AssertEqual(err.Error(), `cannot parse "[1,5" at offset 4: expected ) or ], found end of input`, t)
+}
+
+func TestParseSetNotationErrors(t *testing.T) {
+	tests := []struct {
+		notation string
+		offset   int
+	}{
+		{"x | x < 5}", 0},
+		{"{x | x < 5", 10},
+		{"{x | y < 5}", 5},
+		{"{x | 1 < y < 5}", 9},
+		{"{x | 1 > x}", 7},
+		{"{x | x = 5}", 7},
+		{"{x | x < +∞}", 9},
+		{"{x | -∞ ≤ x}", 15},
+		{"{x | 1 < x ≤ +∞}", 15},
+		{"{1 | x < 5}", 1},
+		{"{+∞}", 1},
+		{"{x | 5 < x < 1}", 5},
+	}
+	for _, test := range tests {
+		_, err := ParseSetNotation[int](test.notation)
+		var parseErr *ParseError
+		AssertTrue(errors.As(err, &parseErr), t)
+		if parseErr != nil {
+			AssertEqual(parseErr.Offset, test.offset, t)
+		}
+	}
+}
+
+/* !SECTION: Parse Testing */
@@ -3,43 +3,193 @@ package interval
 type PointType int // Point Enum Type
 
 const (
-	OpenPoint      PointType = iota // exclusive point
-	ClosedPoint                     // inclusive point
-	UnboundedPoint                  // infinity
+	OpenPoint              PointType = iota // exclusive point
+	ClosedPoint                             // inclusive point
+	UnboundedPoint                          // infinity, -∞ as a LowerBound and +∞ as an UpperBound
+	NegativeUnboundedPoint                  // -∞
+	PositiveUnboundedPoint                  // +∞
 )
 
-/* Point Type to represent a number on a number line. */
+/* Point Type to represent a number on a number line. The Value of an infinite Point is always zero and never read. */
 type Point[N Numeric] struct {
 	Value N
 	Type  PointType
 }
 
+/* Public Function that returns the -∞ Point. */
+func NegativeInfinity[N Numeric]() Point[N] {
+	return Point[N]{Type: NegativeUnboundedPoint}
+}
+
+/* Public Function that returns the +∞ Point. */
+func PositiveInfinity[N Numeric]() Point[N] {
+	return Point[N]{Type: PositiveUnboundedPoint}
+}
+
+/* Public Boolean Method that returns true if the Point is -∞ or +∞. False otherwise. */
+func (self Point[N]) IsInfinite() bool {
+	return self.Type == UnboundedPoint || self.Type == NegativeUnboundedPoint || self.Type == PositiveUnboundedPoint
+}
+
+/* Public Boolean Method that returns true for Open and Closed PointTypes, which hold a Value. */
+func (self PointType) IsFinite() bool {
+	return self == OpenPoint || self == ClosedPoint
+}
+
+type BoundSide int // Bound Side Enum Type
+
+const (
+	LowerSide BoundSide = iota // the point starts an interval
+	UpperSide                  // the point ends an interval
+)
+
+/*
+	Bound Type to represent a Point used as one end of an interval.
+
+	The same Point orders differently depending on the side it bounds: an Open LowerBound at a sits just after a
+	and an Open UpperBound at a sits just before a.
+*/
+type Bound[N Numeric] struct {
+	Point Point[N]
+	Side  BoundSide
+}
+
+/* Public Method that returns the Point as the LowerBound of an interval. */
+func (self Point[N]) AsLower() Bound[N] {
+	return Bound[N]{Point: self, Side: LowerSide}
+}
+
+/* Public Method that returns the Point as the UpperBound of an interval. */
+func (self Point[N]) AsUpper() Bound[N] {
+	return Bound[N]{Point: self, Side: UpperSide}
+}
+
+/*
+	Private Method that places a bound on the extended number line.
+
+	A bound is described by an infinity class (-1 for -∞, 0 for finite, +1 for +∞), its value and an
+	infinitesimal offset: an Open LowerBound sits just after its value (+1) and an Open UpperBound sits just
+	before it (-1). The value of an infinite bound is never read.
+*/
+func (self Bound[N]) position() (class int, value N, offset int) {
+	switch self.Point.Type {
+	case UnboundedPoint:
+		if self.Side == UpperSide {
+			return 1, value, 0
+		}
+		return -1, value, 0
+	case NegativeUnboundedPoint:
+		return -1, value, 0
+	case PositiveUnboundedPoint:
+		return 1, value, 0
+	case OpenPoint:
+		if self.Side == UpperSide {
+			return 0, self.Point.Value, -1
+		}
+		return 0, self.Point.Value, 1
+	}
+	return 0, self.Point.Value, 0
+}
+
+/*
+	Public Function that orders two bounds on the number line.
+
+	Parameters:
+		a Bound[N]
+		b Bound[N]
+	Return:
+		int	-1 if a comes before b, 0 if they coincide and +1 if a comes after b
+*/
+func CompareBounds[N Numeric](a, b Bound[N]) int {
+	aClass, aValue, aOffset := a.position()
+	bClass, bValue, bOffset := b.position()
+	switch {
+	case aClass != bClass:
+		if aClass < bClass {
+			return -1
+		}
+		return 1
+	case aClass != 0:
+		return 0
+	case aValue < bValue:
+		return -1
+	case aValue > bValue:
+		return 1
+	case aOffset < bOffset:
+		return -1
+	case aOffset > bOffset:
+		return 1
+	}
+	return 0
+}
+
+/*
+	Private Boolean function that returns true if an UpperBound u and a LowerBound l leave no gap between them,
+	i.e. if (…,u] ∪ [l,…) is connected. [1,2) and [2,3] touch, (1,2) and (2,3) do not.
+*/
+func boundsTouch[N Numeric](u, l Point[N]) bool {
+	upper, lower := u.AsUpper(), l.AsLower()
+	if CompareBounds(lower, upper) <= 0 {
+		return true
+	}
+	_, uValue, uOffset := upper.position()
+	lClass, lValue, lOffset := lower.position()
+	return lClass == 0 && uValue == lValue && lOffset-uOffset == 1
+}
+
+/* Private Boolean function that returns true if a LowerBound comes after an UpperBound, leaving no Value between them */
+func boundsEmpty[N Numeric](lo, hi Point[N]) bool {
+	return CompareBounds(lo.AsLower(), hi.AsUpper()) > 0
+}
+
+/* Private Boolean function that returns true if a Value lies between a LowerBound and an UpperBound */
+func boundsContain[N Numeric](lo, hi Point[N], Value N) bool {
+	point := Point[N]{Value: Value, Type: ClosedPoint}
+	return CompareBounds(lo.AsLower(), point.AsLower()) <= 0 && CompareBounds(point.AsUpper(), hi.AsUpper()) <= 0
+}
+
 /* Private function that calculates which start point should be used for interval intersection */
 func startPointIntersect[N Numeric](a, b Point[N]) Point[N] {
-	if a.Value == b.Value {
-		if a.Type < b.Type {
-			return a
-		} else {
-			return b
-		}
-	} else if a.Value > b.Value {
+	if CompareBounds(a.AsLower(), b.AsLower()) >= 0 {
 		return a
-	} else {
-		return b
 	}
+	return b
 }
 
 /* Private function that calculates which end point should be used for interval intersection */
 func endPointIntersect[N Numeric](c, d Point[N]) Point[N] {
-	if c.Value == d.Value {
-		if c.Type < d.Type {
-			return c
-		} else {
-			return d
-		}
-	} else if c.Value < d.Value {
+	if CompareBounds(c.AsUpper(), d.AsUpper()) <= 0 {
+		return c
+	}
+	return d
+}
+
+/* Private function that calculates which start point should be used for the hull of two intervals */
+func startPointHull[N Numeric](a, b Point[N]) Point[N] {
+	if CompareBounds(a.AsLower(), b.AsLower()) <= 0 {
+		return a
+	}
+	return b
+}
+
+/* Private function that calculates which end point should be used for the hull of two intervals */
+func endPointHull[N Numeric](c, d Point[N]) Point[N] {
+	if CompareBounds(c.AsUpper(), d.AsUpper()) >= 0 {
 		return c
-	} else {
-		return d
 	}
+	return d
+}
+
+/*
+	Private function that returns the bound on the other side of a finite point: the complement of (…,p] starts
+	at (p and the complement of (…,p) starts at [p, and vice versa.
+*/
+func complementPoint[N Numeric](p Point[N]) Point[N] {
+	switch p.Type {
+	case OpenPoint:
+		return Point[N]{Value: p.Value, Type: ClosedPoint}
+	case ClosedPoint:
+		return Point[N]{Value: p.Value, Type: OpenPoint}
+	}
+	return p
 }
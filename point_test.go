@@ -0,0 +1,49 @@
+package interval
+
+import "testing"
+
+/* SECTION: Bound Comparison Testing */
+
+func TestCompareBounds(t *testing.T) {
+	closed, open := Point[int]{Value: 2, Type: ClosedPoint}, Point[int]{Value: 2, Type: OpenPoint}
+	unbounded := Point[int]{Type: UnboundedPoint}
+	tests := []struct {
+		name     string
+		a, b     Bound[int]
+		expected int
+	}{
+		{"[2 = [2", closed.AsLower(), closed.AsLower(), 0},
+		{"[2 = 2]", closed.AsLower(), closed.AsUpper(), 0},
+		{"[2 < (2", closed.AsLower(), open.AsLower(), -1},
+		{"(2 > 2]", open.AsLower(), closed.AsUpper(), 1},
+		{"2) < 2]", open.AsUpper(), closed.AsUpper(), -1},
+		{"2) < (2", open.AsUpper(), open.AsLower(), -1},
+		{"2) < [2", open.AsUpper(), closed.AsLower(), -1},
+		{"(2 < 3)", open.AsLower(), Point[int]{Value: 3, Type: OpenPoint}.AsUpper(), -1},
+		{"-∞ < [2", unbounded.AsLower(), closed.AsLower(), -1},
+		{"+∞ > 2]", unbounded.AsUpper(), closed.AsUpper(), 1},
+		{"-∞ < +∞", unbounded.AsLower(), unbounded.AsUpper(), -1},
+		{"-∞ = -∞", unbounded.AsLower(), Point[int]{Value: 7, Type: UnboundedPoint}.AsLower(), 0},
+		{"-∞ = -∞ as an UpperBound", unbounded.AsLower(), NegativeInfinity[int]().AsUpper(), 0},
+		{"+∞ = +∞ as a LowerBound", unbounded.AsUpper(), PositiveInfinity[int]().AsLower(), 0},
+		{"-∞ < (2", NegativeInfinity[int]().AsUpper(), open.AsLower(), -1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			AssertEqual(CompareBounds(test.a, test.b), test.expected, t)
+			AssertEqual(CompareBounds(test.b, test.a), -test.expected, t)
+		})
+	}
+}
+
+func TestBoundsTouch(t *testing.T) {
+	AssertTrue(boundsTouch(Point[int]{Value: 2, Type: ClosedPoint}, Point[int]{Value: 2, Type: ClosedPoint}), t)
+	AssertTrue(boundsTouch(Point[int]{Value: 2, Type: ClosedPoint}, Point[int]{Value: 2, Type: OpenPoint}), t)
+	AssertTrue(boundsTouch(Point[int]{Value: 2, Type: OpenPoint}, Point[int]{Value: 2, Type: ClosedPoint}), t)
+	AssertFalse(boundsTouch(Point[int]{Value: 2, Type: OpenPoint}, Point[int]{Value: 2, Type: OpenPoint}), t)
+	AssertFalse(boundsTouch(Point[int]{Value: 2, Type: ClosedPoint}, Point[int]{Value: 3, Type: ClosedPoint}), t)
+	AssertTrue(boundsTouch(Point[int]{Value: 3, Type: OpenPoint}, Point[int]{Value: 2, Type: OpenPoint}), t)
+	AssertTrue(boundsTouch(Point[int]{Type: UnboundedPoint}, Point[int]{Value: 2, Type: OpenPoint}), t)
+}
+
+/* !SECTION: Bound Comparison Testing */
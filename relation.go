@@ -0,0 +1,196 @@
+package interval
+
+type Relation int // Allen Relation Enum Type
+
+const (
+	UndefinedRelation    Relation = iota // either interval is Empty
+	BeforeRelation                       // a ends before b starts, leaving a gap: [1,2) and (2,3]
+	MeetsRelation                        // a ends where b starts, sharing no Value: [1,2) and [2,3]
+	OverlapsRelation                     // a starts first and ends inside b: [1,3] and [2,4]
+	StartsRelation                       // a and b start together, a ends first: [1,2] and [1,3]
+	DuringRelation                       // a lies strictly inside b: [2,3] and [1,4]
+	FinishesRelation                     // a and b end together, a starts last: [2,3] and [1,3]
+	EqualsRelation                       // a and b have the same bounds
+	FinishedByRelation                   // inverse of FinishesRelation
+	ContainsRelation                     // inverse of DuringRelation
+	StartedByRelation                    // inverse of StartsRelation
+	OverlappedByRelation                 // inverse of OverlapsRelation
+	MetByRelation                        // inverse of MeetsRelation
+	AfterRelation                        // inverse of BeforeRelation
+)
+
+/* Public Method that returns the name of the Relation, ex: "overlaps" */
+func (self Relation) String() string {
+	switch self {
+	case BeforeRelation:
+		return "before"
+	case MeetsRelation:
+		return "meets"
+	case OverlapsRelation:
+		return "overlaps"
+	case StartsRelation:
+		return "starts"
+	case DuringRelation:
+		return "during"
+	case FinishesRelation:
+		return "finishes"
+	case EqualsRelation:
+		return "equals"
+	case FinishedByRelation:
+		return "finished by"
+	case ContainsRelation:
+		return "contains"
+	case StartedByRelation:
+		return "started by"
+	case OverlappedByRelation:
+		return "overlapped by"
+	case MetByRelation:
+		return "met by"
+	case AfterRelation:
+		return "after"
+	}
+	return "undefined"
+}
+
+/* Public Method that returns the Relation of b to a given the Relation of a to b, ex: MeetsRelation for MetByRelation */
+func (self Relation) Inverse() Relation {
+	if self == UndefinedRelation {
+		return UndefinedRelation
+	}
+	return AfterRelation + BeforeRelation - self
+}
+
+/*
+	Public Function that returns the Allen Relation of interval a to interval b.
+
+	Endpoints are compared as bounds, so Open and Closed PointTypes decide the result at shared endpoints:
+	[1,2) meets [2,3] while [1,2] overlaps [2,3] since both hold 2, and (1,2) is before (2,3) since neither
+	holds 2. Infinite endpoints coincide with each other, ex: (-∞,1] starts (-∞,3] and (-∞,+∞) equals itself.
+
+	Parameters:
+		a Interval[N]
+		b Interval[N]
+	Return:
+		Relation	UndefinedRelation if either interval is Empty
+*/
+func Relate[N Numeric](a, b Interval[N]) Relation {
+	if a.Type == EmptyInterval || b.Type == EmptyInterval {
+		return UndefinedRelation
+	}
+	if boundsEmpty(b.LowerBound, a.UpperBound) {
+		if boundsTouch(a.UpperBound, b.LowerBound) {
+			return MeetsRelation
+		}
+		return BeforeRelation
+	}
+	if boundsEmpty(a.LowerBound, b.UpperBound) {
+		if boundsTouch(b.UpperBound, a.LowerBound) {
+			return MetByRelation
+		}
+		return AfterRelation
+	}
+	lower := CompareBounds(a.LowerBound.AsLower(), b.LowerBound.AsLower())
+	upper := CompareBounds(a.UpperBound.AsUpper(), b.UpperBound.AsUpper())
+	switch {
+	case lower == 0 && upper == 0:
+		return EqualsRelation
+	case lower == 0 && upper < 0:
+		return StartsRelation
+	case lower == 0:
+		return StartedByRelation
+	case upper == 0 && lower > 0:
+		return FinishesRelation
+	case upper == 0:
+		return FinishedByRelation
+	case lower > 0 && upper < 0:
+		return DuringRelation
+	case lower < 0 && upper > 0:
+		return ContainsRelation
+	case lower < 0:
+		return OverlapsRelation
+	}
+	return OverlappedByRelation
+}
+
+/*
+	Public Boolean Method that returns true if every Value of other is within the interval (other ⊆ self).
+	The Empty Interval is within every interval.
+
+	Parameters:
+		other Interval[N]
+	Return:
+		bool
+*/
+func (self Interval[N]) ContainsInterval(other Interval[N]) bool {
+	if other.Type == EmptyInterval {
+		return true
+	}
+	switch Relate(self, other) {
+	case EqualsRelation, ContainsRelation, StartedByRelation, FinishedByRelation:
+		return true
+	}
+	return false
+}
+
+/* Public Boolean Method that returns true if every Value of the interval is within other (self ⊆ other). */
+func (self Interval[N]) IsSubsetOf(other Interval[N]) bool {
+	return other.ContainsInterval(self)
+}
+
+/* Public Boolean Method that returns true if every Value of other is within the interval (self ⊇ other). */
+func (self Interval[N]) IsSupersetOf(other Interval[N]) bool {
+	return self.ContainsInterval(other)
+}
+
+/*
+	Public Boolean Method that returns true if the interval and other share at least one Value.
+	[1,2] and [2,3] overlap since both hold 2, [1,2) and [2,3] do not.
+
+	Parameters:
+		other Interval[N]
+	Return:
+		bool
+*/
+func (self Interval[N]) Overlaps(other Interval[N]) bool {
+	switch Relate(self, other) {
+	case UndefinedRelation, BeforeRelation, MeetsRelation, MetByRelation, AfterRelation:
+		return false
+	}
+	return true
+}
+
+/* Public Boolean Method that returns true if the interval and other share no Value. Always true if either is Empty. */
+func (self Interval[N]) IsDisjoint(other Interval[N]) bool {
+	return !self.Overlaps(other)
+}
+
+/*
+	Public Boolean Method that returns true if the interval and other touch without sharing a Value, ex: [1,2)
+	and [2,3]. (1,2) and (2,3) are not adjacent since neither holds 2. Always false if either is Empty.
+
+	Parameters:
+		other Interval[N]
+	Return:
+		bool
+*/
+func (self Interval[N]) IsAdjacent(other Interval[N]) bool {
+	relation := Relate(self, other)
+	return relation == MeetsRelation || relation == MetByRelation
+}
+
+/*
+	Public Boolean Method that returns true if the union of the interval and other is a single interval, i.e. if
+	they overlap or are adjacent. An Empty Interval is connected to every interval.
+
+	Parameters:
+		other Interval[N]
+	Return:
+		bool
+*/
+func (self Interval[N]) IsConnected(other Interval[N]) bool {
+	if self.Type == EmptyInterval || other.Type == EmptyInterval {
+		return true
+	}
+	relation := Relate(self, other)
+	return relation != BeforeRelation && relation != AfterRelation
+}
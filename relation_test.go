@@ -0,0 +1,138 @@
+package interval
+
+import "testing"
+
+/* SECTION: Relation Testing */
+
+func TestRelate(t *testing.T) {
+	tests := []struct {
+		a        Interval[int]
+		b        Interval[int]
+		expected Relation
+	}{
+		{GenerateClosedInterval(1, 2), GenerateClosedInterval(4, 5), BeforeRelation},
+		{GenerateOpenInterval(1, 2), GenerateOpenInterval(2, 3), BeforeRelation},
+		{GenerateClosedOpenInterval(1, 2), GenerateClosedInterval(2, 3), MeetsRelation},
+		{GenerateClosedInterval(1, 2), GenerateOpenClosedInterval(2, 3), MeetsRelation},
+		{GenerateClosedInterval(1, 2), GenerateClosedInterval(2, 3), OverlapsRelation},
+		{GenerateClosedInterval(1, 3), GenerateClosedInterval(2, 4), OverlapsRelation},
+		{GenerateClosedInterval(1, 2), GenerateClosedInterval(1, 3), StartsRelation},
+		{GenerateClosedOpenInterval(1, 3), GenerateClosedInterval(1, 3), StartsRelation},
+		{GenerateClosedInterval(2, 3), GenerateClosedInterval(1, 4), DuringRelation},
+		{GenerateClosedInterval(1, 3), GenerateOpenInterval(1, 3), ContainsRelation},
+		{GenerateClosedInterval(2, 3), GenerateClosedInterval(1, 3), FinishesRelation},
+		{GenerateOpenClosedInterval(1, 3), GenerateClosedInterval(1, 3), FinishesRelation},
+		{GenerateClosedInterval(1, 3), GenerateClosedInterval(1, 3), EqualsRelation},
+		{GenerateClosedInterval(1, 3), GenerateClosedInterval(2, 3), FinishedByRelation},
+		{GenerateClosedInterval(1, 4), GenerateClosedInterval(2, 3), ContainsRelation},
+		{GenerateClosedInterval(1, 3), GenerateClosedInterval(1, 2), StartedByRelation},
+		{GenerateClosedInterval(2, 4), GenerateClosedInterval(1, 3), OverlappedByRelation},
+		{GenerateClosedInterval(2, 3), GenerateClosedOpenInterval(1, 2), MetByRelation},
+		{GenerateClosedInterval(4, 5), GenerateClosedInterval(1, 2), AfterRelation},
+		{GenerateClosedInterval(2, 2), GenerateClosedInterval(1, 3), DuringRelation},
+		{GenerateClosedInterval(1, 1), GenerateClosedInterval(1, 3), StartsRelation},
+		{GenerateClosedInterval(1, 1), GenerateOpenInterval(1, 3), MeetsRelation},
+	}
+	for _, test := range tests {
+		AssertEqual(Relate(test.a, test.b), test.expected, t)
+		AssertEqual(Relate(test.b, test.a), test.expected.Inverse(), t)
+	}
+}
+
+func TestRelateUnbounded(t *testing.T) {
+	tests := []struct {
+		a        Interval[float64]
+		b        Interval[float64]
+		expected Relation
+	}{
+		{GenerateAtMostInterval(1.0), GenerateAtMostInterval(3.0), StartsRelation},
+		{GenerateLessThanInterval(3.0), GenerateAtMostInterval(3.0), StartsRelation},
+		{GenerateAtLeastInterval(2.0), GenerateGreaterThanInterval(1.0), FinishesRelation},
+		{GenerateLessThanInterval(1.0), GenerateAtLeastInterval(1.0), MeetsRelation},
+		{GenerateLessThanInterval(1.0), GenerateGreaterThanInterval(1.0), BeforeRelation},
+		{GenerateAtMostInterval(2.0), GenerateAtLeastInterval(1.0), OverlapsRelation},
+		{GenerateClosedInterval(1.0, 2.0), GenerateUnboundedInterval[float64](), DuringRelation},
+		{GenerateAtMostInterval(2.0), GenerateUnboundedInterval[float64](), StartsRelation},
+		{GenerateUnboundedInterval[float64](), GenerateUnboundedInterval[float64](), EqualsRelation},
+	}
+	for _, test := range tests {
+		AssertEqual(Relate(test.a, test.b), test.expected, t)
+		AssertEqual(Relate(test.b, test.a), test.expected.Inverse(), t)
+	}
+}
+
+func TestRelateEmpty(t *testing.T) {
+	empty := GenerateEmptyInterval[int]()
+	AssertEqual(Relate(empty, GenerateClosedInterval(1, 3)), UndefinedRelation, t)
+	AssertEqual(Relate(GenerateClosedInterval(1, 3), empty), UndefinedRelation, t)
+	AssertEqual(Relate(empty, empty), UndefinedRelation, t)
+	AssertEqual(UndefinedRelation.Inverse(), UndefinedRelation, t)
+}
+
+func TestRelationString(t *testing.T) {
+	AssertEqual(MeetsRelation.String(), "meets", t)
+	AssertEqual(OverlappedByRelation.String(), "overlapped by", t)
+	AssertEqual(UndefinedRelation.String(), "undefined", t)
+}
+
+func TestIntervalPredicates(t *testing.T) {
+	tests := []struct {
+		a         Interval[int]
+		b         Interval[int]
+		contains  bool
+		subset    bool
+		overlaps  bool
+		adjacent  bool
+		connected bool
+	}{
+		{GenerateClosedInterval(1, 5), GenerateClosedInterval(2, 3), true, false, true, false, true},
+		{GenerateClosedInterval(1, 5), GenerateClosedInterval(1, 5), true, true, true, false, true},
+		{GenerateOpenInterval(1, 5), GenerateClosedInterval(1, 5), false, true, true, false, true},
+		{GenerateClosedOpenInterval(1, 5), GenerateOpenClosedInterval(1, 5), false, false, true, false, true},
+		{GenerateClosedInterval(1, 2), GenerateClosedInterval(2, 3), false, false, true, false, true},
+		{GenerateClosedOpenInterval(1, 2), GenerateClosedInterval(2, 3), false, false, false, true, true},
+		{GenerateOpenInterval(1, 2), GenerateOpenInterval(2, 3), false, false, false, false, false},
+		{GenerateClosedInterval(1, 2), GenerateClosedInterval(4, 5), false, false, false, false, false},
+		{GenerateAtLeastInterval(1), GenerateClosedInterval(2, 3), true, false, true, false, true},
+		{GenerateAtLeastInterval(1), GenerateGreaterThanInterval(1), true, false, true, false, true},
+		{GenerateLessThanInterval(1), GenerateAtLeastInterval(1), false, false, false, true, true},
+		{GenerateAtMostInterval(1), GenerateGreaterThanInterval(1), false, false, false, true, true},
+		{GenerateUnboundedInterval[int](), GenerateAtMostInterval(3), true, false, true, false, true},
+		{GenerateClosedInterval(1, 1), GenerateOpenInterval(1, 3), false, false, false, true, true},
+		{GenerateClosedInterval(1, 3), GenerateEmptyInterval[int](), true, false, false, false, true},
+		{GenerateEmptyInterval[int](), GenerateEmptyInterval[int](), true, true, false, false, true},
+	}
+	for _, test := range tests {
+		AssertEqual(test.a.ContainsInterval(test.b), test.contains, t)
+		AssertEqual(test.a.IsSupersetOf(test.b), test.contains, t)
+		AssertEqual(test.b.IsSubsetOf(test.a), test.contains, t)
+		AssertEqual(test.a.IsSubsetOf(test.b), test.subset, t)
+		AssertEqual(test.a.Overlaps(test.b), test.overlaps, t)
+		AssertEqual(test.b.Overlaps(test.a), test.overlaps, t)
+		AssertEqual(test.a.IsDisjoint(test.b), !test.overlaps, t)
+		AssertEqual(test.a.IsAdjacent(test.b), test.adjacent, t)
+		AssertEqual(test.b.IsAdjacent(test.a), test.adjacent, t)
+		AssertEqual(test.a.IsConnected(test.b), test.connected, t)
+		AssertEqual(test.b.IsConnected(test.a), test.connected, t)
+	}
+}
+
+func TestIsConnectedMatchesUnion(t *testing.T) {
+	intervals := []Interval[float64]{
+		GenerateEmptyInterval[float64](),
+		GenerateOpenInterval(0.0, 1.0),
+		GenerateClosedInterval(1.0, 2.0),
+		GenerateOpenClosedInterval(2.0, 3.0),
+		GenerateGreaterThanInterval(3.0),
+		GenerateLessThanInterval(0.0),
+		GenerateAtMostInterval(-1.0),
+	}
+	for _, a := range intervals {
+		for _, b := range intervals {
+			union := Union(a, b)
+			AssertEqual(a.IsConnected(b), len(union.Intervals()) <= 1, t)
+		}
+	}
+}
+
+/* !SECTION: Relation Testing */
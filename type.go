@@ -1,7 +1,55 @@
 package interval
 
-import "golang.org/x/exp/constraints"
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
 
 type Numeric interface {
 	constraints.Float | constraints.Integer
 }
+
+/* Private Boolean function that returns true if N is a floating-point type. False for integers. */
+func isFloat[N Numeric]() bool {
+	half := 0.5
+	return N(half) != 0
+}
+
+/* Private Boolean function that returns true if N is a float32 type, whose 24-bit mantissa cannot hold 2^24+1. */
+func isFloat32[N Numeric]() bool {
+	precise := float64(1<<24 + 1)
+	return isFloat[N]() && float64(N(precise)) != precise
+}
+
+/* Private Boolean function that returns true if N is an unsigned integer type. */
+func isUnsigned[N Numeric]() bool {
+	var zero N
+	return zero-1 > zero
+}
+
+/*
+	Private function that returns the Value of N next to a Value: the next representable float, or the next
+	integer. Integers wrap around at the limits of N.
+
+	Parameters:
+		Value N
+		up bool	true for the Value above, false for the Value below
+	Return:
+		N
+*/
+func nextValue[N Numeric](Value N, up bool) N {
+	direction := math.Inf(-1)
+	if up {
+		direction = math.Inf(1)
+	}
+	switch {
+	case isFloat32[N]():
+		return N(math.Nextafter32(float32(Value), float32(direction)))
+	case isFloat[N]():
+		return N(math.Nextafter(float64(Value), direction))
+	case up:
+		return Value + 1
+	}
+	return Value - 1
+}